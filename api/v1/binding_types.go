@@ -17,16 +17,22 @@
 package v1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // BindingSpec defines the desired state of Binding
 type BindingSpec struct {
-	// ServiceClass is the name of the service resource to bind
-	ServiceName string `json:"serviceName"`
+	// ServiceClass is the name of the service resource to bind. Required unless InstanceCRN is set.
+	// +optional
+	ServiceName string `json:"serviceName,omitempty"`
 	// ServiceNamespace is the namespace of the service resource to bind
 	// +optional
 	ServiceNamespace string `json:"serviceNamespace,omitempty"`
+	// InstanceCRN is the CRN of a service instance managed outside of the cluster to bind, instead of a service resource.
+	// Only supported for non-CF services.
+	// +optional
+	InstanceCRN string `json:"instanceCRN,omitempty"`
 	// SecretName is the name of the secret where credentials will be stored
 	// +optional
 	SecretName string `json:"secretName,omitempty"`
@@ -39,6 +45,81 @@ type BindingSpec struct {
 	// Parameters pass configuration to the service during creation
 	// +optional
 	Parameters []Param `json:"parameters,omitempty"`
+	// Credentials create additional credentials for the service instance, each with its own role and parameters.
+	// Their entries are added to the secret prefixed with the credentials' name, or written to their own secret.
+	// Only supported for non-CF services.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Credentials []BindingCredentials `json:"credentials,omitempty"`
+	// SecretTemplate maps each key of the generated secret to a Go template rendered with the service credentials.
+	// When set, only these keys are written to the secret.
+	// +optional
+	SecretTemplate map[string]string `json:"secretTemplate,omitempty"`
+	// Certificates decodes base64 encoded certificates in the credentials into their own secret keys, so they can be mounted as files
+	// +optional
+	Certificates []BindingCertificate `json:"certificates,omitempty"`
+	// TLS sets the secret's type to kubernetes.io/tls. The tls.crt and tls.key keys must be set by certificates or the secret template.
+	// +optional
+	TLS bool `json:"tls,omitempty"`
+	// SecretFormat is ServiceBinding to add the entries of the Service Binding Specification for Kubernetes to the secret,
+	// and set status.binding for spec-compliant tooling. Defaults to Default.
+	// +optional
+	SecretFormat SecretFormat `json:"secretFormat,omitempty"`
+	// ConfigMapKeys are the keys of non-sensitive credentials, such as endpoints, which are written to a ConfigMap instead of the secret.
+	// The ConfigMap has the same name as the secret.
+	// +optional
+	ConfigMapKeys []string `json:"configMapKeys,omitempty"`
+	// TargetNamespaces are additional namespaces where a copy of the secret is kept in sync with the credentials.
+	// Each namespace must list the Binding's namespace in its ibmcloud.ibm.com/acceptSecretsFrom annotation, or it is skipped with a warning event.
+	// +optional
+	TargetNamespaces []string `json:"targetNamespaces,omitempty"`
+	// TargetNamespaceSelector selects additional namespaces by label where a copy of the secret is kept in sync with the credentials.
+	// The selector must not be empty. Selected namespaces which have not opted in with ibmcloud.ibm.com/acceptSecretsFrom are skipped.
+	// +optional
+	TargetNamespaceSelector *metav1.LabelSelector `json:"targetNamespaceSelector,omitempty"`
+	// RestartWorkloads restarts the Deployments, StatefulSets and DaemonSets in the Binding's namespace which use the secret,
+	// or the separate secret of additional credentials, whenever the credentials in the secret change
+	// +optional
+	RestartWorkloads bool `json:"restartWorkloads,omitempty"`
+	// Rotation periodically replaces the credentials with new ones. Only supported for non-CF services: Bindings of CF services with rotation are rejected.
+	// +optional
+	Rotation *BindingRotation `json:"rotation,omitempty"`
+	// DeletionPolicy is Delete to delete the credentials on IBM Cloud when the Binding is deleted, or Retain to leave them in place. Defaults to Delete.
+	// +optional
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
+}
+
+// BindingCredentials describes additional credentials created by a Binding
+type BindingCredentials struct {
+	// Name identifies the credentials. Unless SecretName is set, each entry of the credentials is added to the Binding's secret as <name>_<key>.
+	Name string `json:"name"`
+	// Role is the role for the credentials
+	// +optional
+	Role string `json:"role,omitempty"`
+	// Parameters pass configuration to the service when creating the credentials
+	// +optional
+	Parameters []Param `json:"parameters,omitempty"`
+	// SecretName is the name of a separate secret for the credentials
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
+}
+
+// BindingCertificate locates a base64 encoded certificate in the credentials
+type BindingCertificate struct {
+	// Key is the secret key for the decoded certificate, such as ca.crt
+	Key string `json:"key"`
+	// JSONPath locates the certificate in the credentials, such as {.connection.postgres.certificate.certificate_base64}
+	JSONPath string `json:"jsonPath"`
+}
+
+// BindingRotation configures how often a Binding's credentials are replaced
+type BindingRotation struct {
+	// Interval is the time between rotations, such as 2160h for 90 days
+	Interval metav1.Duration `json:"interval"`
+	// GracePeriod is how long the previous credentials remain valid after a rotation. Must be shorter than the interval.
+	// +optional
+	GracePeriod metav1.Duration `json:"gracePeriod,omitempty"`
 }
 
 // BindingStatus defines the observed state of Binding
@@ -59,6 +140,57 @@ type BindingStatus struct {
 	// SecretName is the name of the generated secret with service credentials
 	// +optional
 	SecretName string `json:"secretName,omitempty"`
+	// SecretHash is a hash of the generated secret's contents, which changes whenever the credentials in the secret do
+	// +optional
+	SecretHash string `json:"secretHash,omitempty"`
+	// ParametersHash is a hash of the resolved parameter values the credentials were created with, including those read from secrets and configmaps,
+	// or "none" if they were created without parameters
+	// +optional
+	ParametersHash string `json:"parametersHash,omitempty"`
+	// Binding references the generated secret when the secret format is ServiceBinding,
+	// making the Binding a provisioned service in the Service Binding Specification for Kubernetes
+	// +optional
+	Binding *corev1.LocalObjectReference `json:"binding,omitempty"`
+	// Credentials are the additional credentials created for the Binding
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Credentials []BindingCredentialsStatus `json:"credentials,omitempty"`
+	// ConfigMapName is the name of the generated ConfigMap with non-sensitive credentials
+	// +optional
+	ConfigMapName string `json:"configMapName,omitempty"`
+	// TargetNamespaces are the other namespaces which currently hold a copy of the secret
+	// +optional
+	TargetNamespaces []string `json:"targetNamespaces,omitempty"`
+	// PreviousKeyInstanceID is the key instance ID of the rotated credentials, until they are deleted after the grace period
+	// +optional
+	PreviousKeyInstanceID string `json:"previousKeyInstanceId,omitempty"`
+	// LastRotated is the time the credentials were last rotated
+	// +optional
+	LastRotated *metav1.Time `json:"lastRotated,omitempty"`
+	// ObservedGeneration is the most recent metadata.generation processed by the controller
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Conditions describe the current state of the binding. Supported types are Ready, CredentialsSynced and Deleting.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// BindingCredentialsStatus is the observed state of additional credentials
+type BindingCredentialsStatus struct {
+	// Name is the name of the credentials in the Binding's spec
+	Name string `json:"name"`
+	// KeyInstanceID is the key instance ID for the credentials
+	// +optional
+	KeyInstanceID string `json:"keyInstanceId,omitempty"`
+	// ParametersHash is a hash of the resolved parameter values the credentials were created with, or "none" if they were created without parameters
+	// +optional
+	ParametersHash string `json:"parametersHash,omitempty"`
+	// SecretName is the name of the separate secret holding the credentials, if any
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -0,0 +1,206 @@
+/*
+ * Copyright 2021 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1
+
+import (
+	"fmt"
+
+	"github.com/IBM-Cloud/bluemix-go/crn"
+	corev1 "k8s.io/api/core/v1"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// KeyIDAnnotation is the Binding annotation holding the IBM Cloud key ID of alias credentials
+const KeyIDAnnotation = "ibmcloud.ibm.com/keyId"
+
+// SetupWebhookWithManager registers the /convert endpoint for older API versions of Binding.
+// Bindings are validated by the controllers package, since some checks look up the bound Service.
+func (r *Binding) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// ValidateSpec checks the fields of the Binding's spec which can be validated without looking up other resources
+func (r *Binding) ValidateSpec() field.ErrorList {
+	specPath := field.NewPath("spec")
+	var allErrs field.ErrorList
+	if r.Spec.ServiceName == "" && r.Spec.InstanceCRN == "" {
+		allErrs = append(allErrs, field.Required(specPath.Child("serviceName"), "the name of the Service to bind is required"))
+	}
+	allErrs = append(allErrs, r.validateInstanceCRN(specPath)...)
+	allErrs = append(allErrs, validateParams(specPath.Child("parameters"), r.Spec.Parameters)...)
+	allErrs = append(allErrs, r.validateCredentials(specPath.Child("credentials"))...)
+	allErrs = append(allErrs, r.validateConfigMapKeys(specPath.Child("configMapKeys"))...)
+	allErrs = append(allErrs, r.validateCertificates(specPath)...)
+	allErrs = append(allErrs, r.validateRotation(specPath.Child("rotation"))...)
+	allErrs = append(allErrs, r.validateTargetNamespaces(specPath)...)
+	return allErrs
+}
+
+// validateInstanceCRN checks the instance CRN names a service instance and replaces the Service reference
+func (r *Binding) validateInstanceCRN(specPath *field.Path) field.ErrorList {
+	if r.Spec.InstanceCRN == "" {
+		return nil
+	}
+	var allErrs field.ErrorList
+	path := specPath.Child("instanceCRN")
+	if r.Spec.ServiceName != "" {
+		allErrs = append(allErrs, field.Forbidden(path, "cannot be set with serviceName"))
+	}
+	if r.Spec.ServiceNamespace != "" {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("serviceNamespace"), "cannot be set with instanceCRN"))
+	}
+	instanceCRN, err := crn.Parse(r.Spec.InstanceCRN)
+	switch {
+	case err != nil:
+		allErrs = append(allErrs, field.Invalid(path, r.Spec.InstanceCRN, err.Error()))
+	case instanceCRN.ServiceName == "" || instanceCRN.ServiceInstance == "":
+		allErrs = append(allErrs, field.Invalid(path, r.Spec.InstanceCRN, "must include the service name and service instance"))
+	}
+	return allErrs
+}
+
+// validateRotation checks the rotation interval is positive and longer than the grace period
+func (r *Binding) validateRotation(path *field.Path) field.ErrorList {
+	rotation := r.Spec.Rotation
+	if rotation == nil {
+		return nil
+	}
+	var allErrs field.ErrorList
+	if r.Spec.Alias != "" {
+		allErrs = append(allErrs, field.Forbidden(path, "alias credentials cannot be rotated"))
+	}
+	if rotation.Interval.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("interval"), rotation.Interval.Duration.String(), "must be greater than zero"))
+	}
+	if rotation.GracePeriod.Duration < 0 || (rotation.Interval.Duration > 0 && rotation.GracePeriod.Duration >= rotation.Interval.Duration) {
+		allErrs = append(allErrs, field.Invalid(path.Child("gracePeriod"), rotation.GracePeriod.Duration.String(), "must not be negative and must be shorter than the interval"))
+	}
+	return allErrs
+}
+
+// validateCredentials checks each additional credentials have a unique name, valid parameters, and a secret name
+// different from the Binding's secret and the other credentials' secrets
+func (r *Binding) validateCredentials(path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if len(r.Spec.Credentials) > 0 && r.Spec.Alias != "" {
+		allErrs = append(allErrs, field.Forbidden(path, "alias credentials cannot have additional credentials"))
+	}
+	bindingSecretName := r.Name
+	if r.Spec.SecretName != "" {
+		bindingSecretName = r.Spec.SecretName
+	}
+	names := make(map[string]bool)
+	secretNames := map[string]bool{bindingSecretName: true}
+	for i, credentials := range r.Spec.Credentials {
+		credentialsPath := path.Index(i)
+		if credentials.Name == "" {
+			allErrs = append(allErrs, field.Required(credentialsPath.Child("name"), "the name of the credentials is required"))
+		} else {
+			for _, msg := range validation.IsDNS1123Label(credentials.Name) {
+				allErrs = append(allErrs, field.Invalid(credentialsPath.Child("name"), credentials.Name, msg))
+			}
+		}
+		if names[credentials.Name] {
+			allErrs = append(allErrs, field.Duplicate(credentialsPath.Child("name"), credentials.Name))
+		}
+		names[credentials.Name] = true
+		allErrs = append(allErrs, validateParams(credentialsPath.Child("parameters"), credentials.Parameters)...)
+		if credentials.SecretName == "" {
+			continue
+		}
+		for _, msg := range validation.IsDNS1123Subdomain(credentials.SecretName) {
+			allErrs = append(allErrs, field.Invalid(credentialsPath.Child("secretName"), credentials.SecretName, msg))
+		}
+		if secretNames[credentials.SecretName] {
+			allErrs = append(allErrs, field.Duplicate(credentialsPath.Child("secretName"), credentials.SecretName))
+		}
+		secretNames[credentials.SecretName] = true
+	}
+	return allErrs
+}
+
+// validateTargetNamespaces checks the target namespaces are valid namespace names and the selector is a valid, non-empty label selector
+func (r *Binding) validateTargetNamespaces(specPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	namespacesPath := specPath.Child("targetNamespaces")
+	for i, namespace := range r.Spec.TargetNamespaces {
+		for _, msg := range validation.IsDNS1123Label(namespace) {
+			allErrs = append(allErrs, field.Invalid(namespacesPath.Index(i), namespace, msg))
+		}
+	}
+	if selector := r.Spec.TargetNamespaceSelector; selector != nil {
+		selectorPath := specPath.Child("targetNamespaceSelector")
+		if len(selector.MatchLabels) == 0 && len(selector.MatchExpressions) == 0 {
+			allErrs = append(allErrs, field.Required(selectorPath.Child("matchLabels"), "matchLabels or matchExpressions are required, since an empty selector matches every namespace"))
+		}
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(selector, selectorPath)...)
+	}
+	return allErrs
+}
+
+// validateCertificates checks each certificate has a unique, valid key, and that TLS secrets have a certificate and private key
+func (r *Binding) validateCertificates(specPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	path := specPath.Child("certificates")
+	keys := make(map[string]bool)
+	for i, certificate := range r.Spec.Certificates {
+		for _, msg := range validation.IsConfigMapKey(certificate.Key) {
+			allErrs = append(allErrs, field.Invalid(path.Index(i).Child("key"), certificate.Key, msg))
+		}
+		if keys[certificate.Key] {
+			allErrs = append(allErrs, field.Duplicate(path.Index(i).Child("key"), certificate.Key))
+		}
+		keys[certificate.Key] = true
+	}
+
+	if r.Spec.TLS {
+		tlsPath := specPath.Child("tls")
+		if r.Spec.SecretFormat == SecretFormatServiceBinding {
+			allErrs = append(allErrs, field.Forbidden(tlsPath, "TLS secrets cannot use the ServiceBinding secret format"))
+		}
+		for _, key := range []string{corev1.TLSCertKey, corev1.TLSPrivateKeyKey} {
+			if _, inTemplate := r.Spec.SecretTemplate[key]; !keys[key] && !inTemplate {
+				allErrs = append(allErrs, field.Invalid(tlsPath, r.Spec.TLS, fmt.Sprintf("TLS secrets require the %s key from certificates or the secret template", key)))
+			}
+		}
+	}
+	return allErrs
+}
+
+// validateConfigMapKeys checks each key is a valid ConfigMap key, listed once, and rendered by the secret template if there is one
+func (r *Binding) validateConfigMapKeys(path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	seen := make(map[string]bool)
+	for i, key := range r.Spec.ConfigMapKeys {
+		for _, msg := range validation.IsConfigMapKey(key) {
+			allErrs = append(allErrs, field.Invalid(path.Index(i), key, msg))
+		}
+		if seen[key] {
+			allErrs = append(allErrs, field.Duplicate(path.Index(i), key))
+		}
+		seen[key] = true
+		if _, inTemplate := r.Spec.SecretTemplate[key]; len(r.Spec.SecretTemplate) > 0 && !inTemplate {
+			allErrs = append(allErrs, field.Invalid(path.Index(i), key, "must be a key of the secret template"))
+		}
+	}
+	return allErrs
+}
@@ -0,0 +1,159 @@
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestBindingValidateSpec(t *testing.T) {
+	for _, tc := range []struct {
+		description string
+		spec        BindingSpec
+		annotations map[string]string
+		expectErr   string
+	}{
+		{
+			description: "happy path",
+			spec:        BindingSpec{ServiceName: "myservice"},
+		},
+		{
+			description: "missing service name",
+			expectErr:   `spec.serviceName: Required value: the name of the Service to bind is required`,
+		},
+		{
+			description: "rotation",
+			spec: BindingSpec{ServiceName: "myservice", Rotation: &BindingRotation{
+				Interval:    metav1.Duration{Duration: 90 * 24 * time.Hour},
+				GracePeriod: metav1.Duration{Duration: 24 * time.Hour},
+			}},
+		},
+		{
+			description: "rotation grace period longer than interval",
+			spec: BindingSpec{ServiceName: "myservice", Rotation: &BindingRotation{
+				Interval:    metav1.Duration{Duration: time.Hour},
+				GracePeriod: metav1.Duration{Duration: 2 * time.Hour},
+			}},
+			expectErr: `spec.rotation.gracePeriod: Invalid value: "2h0m0s": must not be negative and must be shorter than the interval`,
+		},
+		{
+			description: "rotation of alias credentials",
+			spec:        BindingSpec{ServiceName: "mycfservice", Alias: "mycredentials", Rotation: &BindingRotation{}},
+			expectErr:   `[spec.rotation: Forbidden: alias credentials cannot be rotated, spec.rotation.interval: Invalid value: "0s": must be greater than zero]`,
+		},
+		{
+			description: "configmap keys",
+			spec:        BindingSpec{ServiceName: "myservice", ConfigMapKeys: []string{"endpoint", "region"}},
+		},
+		{
+			description: "invalid configmap keys",
+			spec: BindingSpec{
+				ServiceName:    "myservice",
+				SecretTemplate: map[string]string{"URL": "{{ .url }}"},
+				ConfigMapKeys:  []string{"URL", "URL", "region"},
+			},
+			expectErr: `[spec.configMapKeys[1]: Duplicate value: "URL", spec.configMapKeys[2]: Invalid value: "region": must be a key of the secret template]`,
+		},
+		{
+			description: "certificates",
+			spec: BindingSpec{
+				ServiceName: "myservice",
+				TLS:         true,
+				Certificates: []BindingCertificate{
+					{Key: "tls.crt", JSONPath: "{.certificate.cert_base64}"},
+					{Key: "tls.key", JSONPath: ".certificate.key_base64"},
+				},
+			},
+		},
+		{
+			description: "invalid certificates",
+			spec: BindingSpec{
+				ServiceName:  "myservice",
+				TLS:          true,
+				SecretFormat: SecretFormatServiceBinding,
+				Certificates: []BindingCertificate{
+					{Key: "ca.crt", JSONPath: "{.certificate[}"},
+					{Key: "ca.crt", JSONPath: "{.certificate}"},
+				},
+			},
+			expectErr: `[spec.certificates[1].key: Duplicate value: "ca.crt", spec.tls: Forbidden: TLS secrets cannot use the ServiceBinding secret format, spec.tls: Invalid value: true: TLS secrets require the tls.crt key from certificates or the secret template, spec.tls: Invalid value: true: TLS secrets require the tls.key key from certificates or the secret template]`,
+		},
+		{
+			description: "target namespaces",
+			spec: BindingSpec{
+				ServiceName:             "myservice",
+				TargetNamespaces:        []string{"app1", "app2"},
+				TargetNamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}},
+			},
+		},
+		{
+			description: "invalid target namespaces",
+			spec: BindingSpec{
+				ServiceName:      "myservice",
+				TargetNamespaces: []string{"Not_A_Namespace"},
+				TargetNamespaceSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "team", Operator: metav1.LabelSelectorOpIn},
+				}},
+			},
+			expectErr: `[spec.targetNamespaces[0]: Invalid value: "Not_A_Namespace": a DNS-1123 label must consist of lower case alphanumeric characters or '-', and must start and end with an alphanumeric character (e.g. 'my-name',  or '123-abc', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?'), spec.targetNamespaceSelector.matchExpressions[0].values: Required value: must be specified when ` + "`operator`" + ` is 'In' or 'NotIn']`,
+		},
+		{
+			description: "empty target namespace selector",
+			spec: BindingSpec{
+				ServiceName:             "myservice",
+				TargetNamespaceSelector: &metav1.LabelSelector{},
+			},
+			expectErr: `spec.targetNamespaceSelector.matchLabels: Required value: matchLabels or matchExpressions are required, since an empty selector matches every namespace`,
+		},
+		{
+			description: "additional credentials",
+			spec: BindingSpec{ServiceName: "myservice", Role: "Writer", Credentials: []BindingCredentials{
+				{Name: "reader", Role: "Reader"},
+				{Name: "analytics", Role: "Reader", SecretName: "analytics-credentials"},
+			}},
+		},
+		{
+			description: "invalid additional credentials",
+			spec: BindingSpec{ServiceName: "myservice", Alias: "mycredentials", Credentials: []BindingCredentials{
+				{Name: "Reader"},
+				{Name: "writer", SecretName: "mybinding"},
+				{Name: "writer"},
+			}},
+			annotations: map[string]string{KeyIDAnnotation: "mykey"},
+			expectErr:   `[spec.credentials: Forbidden: alias credentials cannot have additional credentials, spec.credentials[0].name: Invalid value: "Reader": a DNS-1123 label must consist of lower case alphanumeric characters or '-', and must start and end with an alphanumeric character (e.g. 'my-name',  or '123-abc', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?'), spec.credentials[1].secretName: Duplicate value: "mybinding", spec.credentials[2].name: Duplicate value: "writer"]`,
+		},
+		{
+			description: "instance CRN",
+			spec:        BindingSpec{InstanceCRN: "crn:v1:bluemix:public:cloud-object-storage:global:a/myaccount:myinstance::"},
+		},
+		{
+			description: "invalid instance CRN",
+			spec: BindingSpec{
+				ServiceName:      "myservice",
+				ServiceNamespace: "othernamespace",
+				InstanceCRN:      "crn:v1:bluemix:public:cloud-object-storage:global:a/myaccount:::",
+			},
+			expectErr: `[spec.instanceCRN: Forbidden: cannot be set with serviceName, spec.serviceNamespace: Forbidden: cannot be set with instanceCRN, spec.instanceCRN: Invalid value: "crn:v1:bluemix:public:cloud-object-storage:global:a/myaccount:::": must include the service name and service instance]`,
+		},
+		{
+			description: "malformed instance CRN",
+			spec:        BindingSpec{InstanceCRN: "myinstance"},
+			expectErr:   `spec.instanceCRN: Invalid value: "myinstance": malformed CRN`,
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			binding := &Binding{
+				ObjectMeta: metav1.ObjectMeta{Name: "mybinding", Namespace: "mynamespace", Annotations: tc.annotations},
+				Spec:       tc.spec,
+			}
+			err := binding.ValidateSpec().ToAggregate()
+			if tc.expectErr != "" {
+				assert.EqualError(t, err, tc.expectErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,93 @@
+/*
+ * Copyright 2021 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ConditionReady indicates the resource is fully synchronized with IBM Cloud
+	ConditionReady = "Ready"
+	// ConditionProvisioned indicates the service instance exists on IBM Cloud
+	ConditionProvisioned = "Provisioned"
+	// ConditionParametersApplied indicates the service instance is up to date with the parameters and tags in the spec
+	ConditionParametersApplied = "ParametersApplied"
+	// ConditionPlanUpdated indicates the service instance has moved to the plan in the spec.
+	// It is only present once the plan of an existing service instance has been changed.
+	ConditionPlanUpdated = "PlanUpdated"
+	// ConditionCredentialsSynced indicates the service credentials exist on IBM Cloud and are stored in the binding's secret
+	ConditionCredentialsSynced = "CredentialsSynced"
+	// ConditionDeleting indicates the resource has been marked for deletion and is being cleaned up
+	ConditionDeleting = "Deleting"
+)
+
+// Condition describes one aspect of the current state of a resource.
+// It follows the conventions of the upstream Kubernetes metav1.Condition type.
+type Condition struct {
+	// Type of condition in CamelCase, e.g. Ready
+	Type string `json:"type"`
+	// Status of the condition, one of True, False or Unknown
+	// +kubebuilder:validation:Enum=True;False;Unknown
+	Status corev1.ConditionStatus `json:"status"`
+	// ObservedGeneration is the metadata.generation the condition was set from
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// LastTransitionTime is the last time the condition changed from one status to another
+	LastTransitionTime metav1.Time `json:"lastTransitionTime"`
+	// Reason is a CamelCase identifier for the condition's last transition
+	Reason string `json:"reason"`
+	// Message is a human readable description of the condition
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// FindCondition returns the condition of the given type, or nil if it is not present
+func FindCondition(conditions []Condition, conditionType string) *Condition {
+	for i := range conditions {
+		if conditions[i].Type == conditionType {
+			return &conditions[i]
+		}
+	}
+	return nil
+}
+
+// SetCondition adds newCondition to conditions, replacing any existing condition of the same type.
+// The existing LastTransitionTime is kept if the condition's status did not change.
+func SetCondition(conditions *[]Condition, newCondition Condition) {
+	existing := FindCondition(*conditions, newCondition.Type)
+	if existing == nil {
+		if newCondition.LastTransitionTime.IsZero() {
+			newCondition.LastTransitionTime = metav1.Now()
+		}
+		*conditions = append(*conditions, newCondition)
+		return
+	}
+	if existing.Status == newCondition.Status {
+		newCondition.LastTransitionTime = existing.LastTransitionTime
+	} else if newCondition.LastTransitionTime.IsZero() {
+		newCondition.LastTransitionTime = metav1.Now()
+	}
+	*existing = newCondition
+}
+
+// IsConditionTrue returns true if the condition of the given type is present and has status True
+func IsConditionTrue(conditions []Condition, conditionType string) bool {
+	condition := FindCondition(conditions, conditionType)
+	return condition != nil && condition.Status == corev1.ConditionTrue
+}
@@ -0,0 +1,246 @@
+/*
+ * Copyright 2021 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ConversionDataAnnotation holds the spec and status fields of an object that its current API version can't represent.
+// They are restored from it when converting to a version that can.
+const ConversionDataAnnotation = "ibmcloud.ibm.com/conversion-data"
+
+// Hub marks v1 as the conversion hub for Service
+func (*Service) Hub() {}
+
+// Hub marks v1 as the conversion hub for Binding
+func (*Binding) Hub() {}
+
+var (
+	jsonMarshalerType   = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+)
+
+// Convert converts src to dst, a different API version of the same kind, by matching their JSON field names.
+// Spec and status fields dst can't represent are kept in dst's ConversionDataAnnotation, so a later conversion back restores them.
+func Convert(src, dst runtime.Object) error {
+	data, err := toJSONMap(src)
+	if err != nil {
+		return err
+	}
+	delete(data, "apiVersion")
+	delete(data, "kind")
+
+	metadata, _ := data["metadata"].(map[string]interface{})
+	annotations, _ := metadata["annotations"].(map[string]interface{})
+	if conversionData, ok := annotations[ConversionDataAnnotation].(string); ok {
+		delete(annotations, ConversionDataAnnotation)
+		if len(annotations) == 0 {
+			delete(metadata, "annotations")
+		}
+		var restored map[string]interface{}
+		if err := json.Unmarshal([]byte(conversionData), &restored); err != nil {
+			return err
+		}
+		// fields set on src win over those restored from an earlier conversion
+		data = mergeJSONMaps(restored, data)
+	}
+
+	dataBytes, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	gvk := dst.GetObjectKind().GroupVersionKind()
+	dstValue := reflect.ValueOf(dst).Elem()
+	dstValue.Set(reflect.Zero(dstValue.Type()))
+	if err := json.Unmarshal(dataBytes, dst); err != nil {
+		return err
+	}
+	dst.GetObjectKind().SetGroupVersionKind(gvk)
+
+	unrepresented := make(map[string]interface{})
+	for _, field := range []string{"spec", "status"} {
+		fieldData, ok := data[field].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		fieldType, _ := jsonFieldType(dstValue.Type(), field)
+		if fieldData = unrepresentedFields(fieldData, fieldType); len(fieldData) > 0 {
+			unrepresented[field] = fieldData
+		}
+	}
+	if len(unrepresented) == 0 {
+		return nil
+	}
+	unrepresentedBytes, err := json.Marshal(unrepresented)
+	if err != nil {
+		return err
+	}
+	dstMeta, err := meta.Accessor(dst)
+	if err != nil {
+		return err
+	}
+	dstAnnotations := make(map[string]string)
+	for key, value := range dstMeta.GetAnnotations() {
+		dstAnnotations[key] = value
+	}
+	dstAnnotations[ConversionDataAnnotation] = string(unrepresentedBytes)
+	dstMeta.SetAnnotations(dstAnnotations)
+	return nil
+}
+
+// PruneConversionData removes the fields obj can represent from its ConversionDataAnnotation.
+// Earlier versions stored the whole spec and status of converted objects, including parameter values.
+// Returns true if the annotation changed.
+func PruneConversionData(obj runtime.Object) (bool, error) {
+	objMeta, err := meta.Accessor(obj)
+	if err != nil {
+		return false, err
+	}
+	conversionData, ok := objMeta.GetAnnotations()[ConversionDataAnnotation]
+	if !ok {
+		return false, nil
+	}
+	var data map[string]interface{}
+	if err := json.Unmarshal([]byte(conversionData), &data); err != nil {
+		return false, err
+	}
+	objType := reflect.TypeOf(obj).Elem()
+	pruned := make(map[string]interface{})
+	for field, fieldData := range data {
+		fieldMap, ok := fieldData.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		fieldType, _ := jsonFieldType(objType, field)
+		if fieldMap = unrepresentedFields(fieldMap, fieldType); len(fieldMap) > 0 {
+			pruned[field] = fieldMap
+		}
+	}
+
+	annotations := make(map[string]string)
+	for key, value := range objMeta.GetAnnotations() {
+		annotations[key] = value
+	}
+	if len(pruned) == 0 {
+		delete(annotations, ConversionDataAnnotation)
+	} else {
+		prunedBytes, err := json.Marshal(pruned)
+		if err != nil {
+			return false, err
+		}
+		if string(prunedBytes) == conversionData {
+			return false, nil
+		}
+		annotations[ConversionDataAnnotation] = string(prunedBytes)
+	}
+	if len(annotations) == 0 {
+		annotations = nil
+	}
+	objMeta.SetAnnotations(annotations)
+	return true, nil
+}
+
+func toJSONMap(obj interface{}) (map[string]interface{}, error) {
+	objBytes, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	var data map[string]interface{}
+	return data, json.Unmarshal(objBytes, &data)
+}
+
+// mergeJSONMaps returns base with the values of override set on top, merging nested objects
+func mergeJSONMaps(base, override map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{}, len(base))
+	for key, value := range base {
+		merged[key] = value
+	}
+	for key, value := range override {
+		baseMap, baseIsMap := merged[key].(map[string]interface{})
+		overrideMap, overrideIsMap := value.(map[string]interface{})
+		if baseIsMap && overrideIsMap {
+			merged[key] = mergeJSONMaps(baseMap, overrideMap)
+		} else {
+			merged[key] = value
+		}
+	}
+	return merged
+}
+
+// unrepresentedFields returns the fields of data which have no matching JSON field in type t
+func unrepresentedFields(data map[string]interface{}, t reflect.Type) map[string]interface{} {
+	unrepresented := make(map[string]interface{})
+	for key, value := range data {
+		fieldType, ok := jsonFieldType(t, key)
+		if !ok {
+			unrepresented[key] = value
+			continue
+		}
+		valueMap, isMap := value.(map[string]interface{})
+		if isMap && fieldType.Kind() == reflect.Struct && !hasCustomJSON(fieldType) {
+			if nested := unrepresentedFields(valueMap, fieldType); len(nested) > 0 {
+				unrepresented[key] = nested
+			}
+		}
+	}
+	return unrepresented
+}
+
+// jsonFieldType returns the type of the struct field of t encoded as the JSON field name, dereferencing pointers
+func jsonFieldType(t reflect.Type, name string) (reflect.Type, bool) {
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, false
+	}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tagName := strings.Split(field.Tag.Get("json"), ",")[0]
+		if tagName == "-" {
+			continue
+		}
+		if field.Anonymous && tagName == "" {
+			if fieldType, ok := jsonFieldType(field.Type, name); ok {
+				return fieldType, true
+			}
+			continue
+		}
+		if tagName == "" {
+			tagName = field.Name
+		}
+		if tagName == name {
+			fieldType := field.Type
+			for fieldType.Kind() == reflect.Ptr {
+				fieldType = fieldType.Elem()
+			}
+			return fieldType, true
+		}
+	}
+	return nil, false
+}
+
+func hasCustomJSON(t reflect.Type) bool {
+	ptr := reflect.PtrTo(t)
+	return t.Implements(jsonMarshalerType) || ptr.Implements(jsonMarshalerType) || ptr.Implements(jsonUnmarshalerType)
+}
@@ -0,0 +1,64 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPruneConversionData(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		description       string
+		annotations       map[string]string
+		expectChanged     bool
+		expectAnnotations map[string]string
+	}{
+		{
+			description: "no annotation",
+		},
+		{
+			description: "only unrepresentable fields",
+			annotations: map[string]string{
+				ConversionDataAnnotation: `{"spec":{"context":{"resourcegroup":"default"}}}`,
+			},
+			expectAnnotations: map[string]string{
+				ConversionDataAnnotation: `{"spec":{"context":{"resourcegroup":"default"}}}`,
+			},
+		},
+		{
+			description: "full spec and status",
+			annotations: map[string]string{
+				"some-annotation": "some-value",
+				ConversionDataAnnotation: `{
+					"spec":{"plan":"Lite","parameters":[{"name":"password","value":"hunter2"}],"context":{"region":"us-south","resourcegroup":"default"}},
+					"status":{"parameters":[{"name":"password","value":"hunter2"}],"context":{"resourcegroupid":"mygroup"}}
+				}`,
+			},
+			expectChanged: true,
+			expectAnnotations: map[string]string{
+				"some-annotation":        "some-value",
+				ConversionDataAnnotation: `{"spec":{"context":{"resourcegroup":"default"}}}`,
+			},
+		},
+		{
+			description: "nothing unrepresentable",
+			annotations: map[string]string{
+				ConversionDataAnnotation: `{"status":{"parameters":[{"name":"password","value":"hunter2"}]}}`,
+			},
+			expectChanged: true,
+		},
+	} {
+		tc := tc // enable parallel sub-tests
+		t.Run(tc.description, func(t *testing.T) {
+			t.Parallel()
+			service := &Service{ObjectMeta: metav1.ObjectMeta{Annotations: tc.annotations}}
+			changed, err := PruneConversionData(service)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectChanged, changed)
+			assert.Equal(t, tc.expectAnnotations, service.Annotations)
+		})
+	}
+}
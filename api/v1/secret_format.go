@@ -0,0 +1,29 @@
+/*
+ * Copyright 2020 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1
+
+// SecretFormat controls the layout of the secret generated by a Binding
+// +kubebuilder:validation:Enum=Default;ServiceBinding
+type SecretFormat string
+
+const (
+	// SecretFormatDefault writes each credential field to its own secret key. This is the default.
+	SecretFormatDefault SecretFormat = "Default"
+	// SecretFormatServiceBinding adds the type, provider and well-known keys of the Service Binding Specification for Kubernetes,
+	// so spec-compliant tooling can project the secret into workloads
+	SecretFormatServiceBinding SecretFormat = "ServiceBinding"
+)
@@ -39,6 +39,24 @@ type ServiceSpec struct {
 	Tags []string `json:"tags,omitempty"`
 	// +optional
 	Context ResourceContext `json:"context,omitempty"`
+	// DeletionPolicy is Delete to delete the service instance on IBM Cloud when the Service is deleted, or Retain to leave it in place. Defaults to Delete.
+	// +optional
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
+	// AllowedBindingNamespaces are the other namespaces whose Bindings may create credentials for the service.
+	// Bindings in the Service's own namespace are always allowed.
+	// +optional
+	AllowedBindingNamespaces []string `json:"allowedBindingNamespaces,omitempty"`
+	// AllowedBindingNamespaceSelector selects by label the other namespaces whose Bindings may create credentials for the service.
+	// The selector must not be empty.
+	// +optional
+	AllowedBindingNamespaceSelector *metav1.LabelSelector `json:"allowedBindingNamespaceSelector,omitempty"`
+	// ManagementPolicy is Manage to create, update and delete the service instance on IBM Cloud, or Observe to only report the state of an existing instance. Defaults to Manage.
+	// +optional
+	ManagementPolicy ManagementPolicy `json:"managementPolicy,omitempty"`
+	// AdoptInstanceID is the ID of an existing service instance on IBM Cloud to bring under full management instead of creating one.
+	// The instance is then renamed, updated and deleted like one the operator created. Not supported for CF services, the Alias plan or the Observe policy.
+	// +optional
+	AdoptInstanceID string `json:"adoptInstanceID,omitempty"`
 }
 
 // ServiceStatus defines the observed state of Service
@@ -62,16 +80,34 @@ type ServiceStatus struct {
 	// ExternalName is the name for the service as it appears on IBM Cloud
 	// +optional
 	ExternalName string `json:"externalName,omitempty"`
+	// PreviousExternalName is the name the service had on IBM Cloud before it was last renamed
+	// +optional
+	PreviousExternalName string `json:"previousExternalName,omitempty"`
 	// +optional
 	Context ResourceContext `json:"context,omitempty"`
-	// Parameters pass configuration to the service during creation
+	// Parameters are no longer set, since their values may be sensitive. The operator removes parameters set by earlier versions.
+	// Deprecated: use ParameterNames and ParametersHash instead.
 	// +optional
 	Parameters []Param `json:"parameters,omitempty"`
+	// ParameterNames are the names of the parameters last applied to the service instance
+	// +optional
+	ParameterNames []string `json:"parameterNames,omitempty"`
+	// ParametersHash is a hash of the resolved parameter values, including those read from secrets and configmaps
+	// +optional
+	ParametersHash string `json:"parametersHash,omitempty"`
 	// +optional
 	Tags []string `json:"tags,omitempty"`
 	// DashboardURL is the dashboard URL for the service
 	// +optional
 	DashboardURL string `json:"dashboardURL,omitempty"`
+	// ObservedGeneration is the most recent metadata.generation processed by the controller
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Conditions describe the current state of the service. Supported types are Ready, Provisioned, ParametersApplied and Deleting.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []Condition `json:"conditions,omitempty"`
 }
 
 // +kubebuilder:object:root=true
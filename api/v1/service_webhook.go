@@ -0,0 +1,180 @@
+/*
+ * Copyright 2021 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1
+
+import (
+	"fmt"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// aliasPlan is the plan name used to bind to an existing service instance
+const aliasPlan = "Alias"
+
+// SetupWebhookWithManager registers the Service webhooks, including the /convert endpoint for older API versions
+func (r *Service) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-ibmcloud-ibm-com-v1-service,mutating=false,failurePolicy=fail,sideEffects=None,groups=ibmcloud.ibm.com,resources=services,verbs=create;update,versions=v1,name=vservice.ibmcloud.ibm.com,admissionReviewVersions=v1beta1
+
+var _ webhook.Validator = &Service{}
+
+// ValidateCreate implements webhook.Validator
+func (r *Service) ValidateCreate() error {
+	return r.validate(nil)
+}
+
+// ValidateUpdate implements webhook.Validator
+func (r *Service) ValidateUpdate(old runtime.Object) error {
+	if !r.DeletionTimestamp.IsZero() {
+		// never block the updates which remove finalizers
+		return nil
+	}
+	oldService, ok := old.(*Service)
+	if !ok {
+		return fmt.Errorf("expected a Service but got a %T", old)
+	}
+	return r.validate(oldService)
+}
+
+// ValidateDelete implements webhook.Validator
+func (r *Service) ValidateDelete() error {
+	return nil
+}
+
+func (r *Service) validate(old *Service) error {
+	specPath := field.NewPath("spec")
+	allErrs := validateParams(specPath.Child("parameters"), r.Spec.Parameters)
+	allErrs = append(allErrs, r.validateAllowedBindingNamespaces(specPath)...)
+	allErrs = append(allErrs, r.validateAdoptInstanceID(specPath)...)
+	if old != nil {
+		allErrs = append(allErrs, r.validateImmutableFields(specPath, old)...)
+	}
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(GroupVersion.WithKind("Service").GroupKind(), r.Name, allErrs)
+}
+
+// validateAllowedBindingNamespaces checks the allowed namespaces are valid namespace names and the selector is a valid, non-empty label selector
+func (r *Service) validateAllowedBindingNamespaces(specPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	namespacesPath := specPath.Child("allowedBindingNamespaces")
+	for i, namespace := range r.Spec.AllowedBindingNamespaces {
+		for _, msg := range validation.IsDNS1123Label(namespace) {
+			allErrs = append(allErrs, field.Invalid(namespacesPath.Index(i), namespace, msg))
+		}
+	}
+	if selector := r.Spec.AllowedBindingNamespaceSelector; selector != nil {
+		selectorPath := specPath.Child("allowedBindingNamespaceSelector")
+		if len(selector.MatchLabels) == 0 && len(selector.MatchExpressions) == 0 {
+			allErrs = append(allErrs, field.Required(selectorPath.Child("matchLabels"), "matchLabels or matchExpressions are required, since an empty selector matches every namespace"))
+		}
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(selector, selectorPath)...)
+	}
+	return allErrs
+}
+
+// validateAdoptInstanceID checks the Service to adopt an instance is a non-CF Service with a regular plan, managed by the operator
+func (r *Service) validateAdoptInstanceID(specPath *field.Path) field.ErrorList {
+	if r.Spec.AdoptInstanceID == "" {
+		return nil
+	}
+	path := specPath.Child("adoptInstanceID")
+	var allErrs field.ErrorList
+	if r.Spec.ServiceClassType == "CF" {
+		allErrs = append(allErrs, field.Forbidden(path, "CF service instances cannot be adopted"))
+	}
+	if strings.EqualFold(r.Spec.Plan, aliasPlan) {
+		allErrs = append(allErrs, field.Forbidden(path, "cannot be set with the Alias plan, use the ibmcloud.ibm.com/instanceId annotation instead"))
+	}
+	if r.Spec.ManagementPolicy == ManagementPolicyObserve {
+		allErrs = append(allErrs, field.Forbidden(path, "cannot be set with the Observe management policy, use the ibmcloud.ibm.com/instanceId annotation instead"))
+	}
+	return allErrs
+}
+
+// validateImmutableFields rejects the spec changes the controller would otherwise revert.
+// A field may still be set back to the value recorded in the status.
+func (r *Service) validateImmutableFields(specPath *field.Path, old *Service) field.ErrorList {
+	if old.Status.Plan == "" {
+		// not provisioned yet, so nothing is immutable
+		return nil
+	}
+	type immutableField struct {
+		name                  string
+		value, prev, observed interface{}
+	}
+	fields := []immutableField{
+		{"serviceClass", r.Spec.ServiceClass, old.Spec.ServiceClass, old.Status.ServiceClass},
+		{"serviceClassType", r.Spec.ServiceClassType, old.Spec.ServiceClassType, old.Status.ServiceClassType},
+		{"context", r.Spec.Context, old.Spec.Context, old.Status.Context},
+	}
+	if !r.canUpdateInPlace(old) {
+		fields = append(fields,
+			immutableField{"plan", r.Spec.Plan, old.Spec.Plan, old.Status.Plan},
+			immutableField{"externalName", r.Spec.ExternalName, old.Spec.ExternalName, old.Status.ExternalName},
+		)
+	}
+
+	var allErrs field.ErrorList
+	for _, f := range fields {
+		if f.value != f.prev && f.value != f.observed {
+			allErrs = append(allErrs, field.Forbidden(specPath.Child(f.name), "field is immutable"))
+		}
+	}
+	return allErrs
+}
+
+// canUpdateInPlace returns true if the service instance can be moved to a new plan or renamed in place.
+// CF services and the Alias plan do not support either.
+func (r *Service) canUpdateInPlace(old *Service) bool {
+	return r.Spec.ServiceClassType != "CF" &&
+		!strings.EqualFold(old.Status.Plan, aliasPlan) &&
+		!strings.EqualFold(r.Spec.Plan, aliasPlan)
+}
+
+// validateParams checks each Param sets at most one source for its value
+func validateParams(path *field.Path, params []Param) field.ErrorList {
+	var allErrs field.ErrorList
+	for i, p := range params {
+		paramPath := path.Index(i)
+		if p.Value != nil && p.ValueFrom != nil {
+			allErrs = append(allErrs, field.Invalid(paramPath, p.Name, "value and valueFrom are mutually exclusive"))
+			continue
+		}
+		if p.ValueFrom != nil {
+			switch {
+			case p.ValueFrom.SecretKeyRef != nil && p.ValueFrom.ConfigMapKeyRef != nil:
+				allErrs = append(allErrs, field.Invalid(paramPath.Child("valueFrom"), p.Name, "secretKeyRef and configMapKeyRef are mutually exclusive"))
+			case p.ValueFrom.SecretKeyRef == nil && p.ValueFrom.ConfigMapKeyRef == nil:
+				allErrs = append(allErrs, field.Required(paramPath.Child("valueFrom"), "secretKeyRef or configMapKeyRef is required"))
+			}
+		}
+	}
+	return allErrs
+}
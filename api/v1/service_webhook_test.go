@@ -0,0 +1,239 @@
+package v1
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestServiceValidateCreate(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		description       string
+		params            []Param
+		allowedNamespaces []string
+		allowedSelector   *metav1.LabelSelector
+		expectErr         string
+	}{
+		{
+			description: "no params",
+		},
+		{
+			description: "value and valueFrom",
+			params: []Param{{
+				Name:      "myparam",
+				Value:     &ParamValue{RawMessage: json.RawMessage(`"value"`)},
+				ValueFrom: &ParamSource{SecretKeyRef: &corev1.SecretKeySelector{Key: "mykey"}},
+			}},
+			expectErr: `Service.ibmcloud.ibm.com "myservice" is invalid: spec.parameters[0]: Invalid value: "myparam": value and valueFrom are mutually exclusive`,
+		},
+		{
+			description: "empty valueFrom",
+			params:      []Param{{Name: "myparam", ValueFrom: &ParamSource{}}},
+			expectErr:   `Service.ibmcloud.ibm.com "myservice" is invalid: spec.parameters[0].valueFrom: Required value: secretKeyRef or configMapKeyRef is required`,
+		},
+		{
+			description: "secretKeyRef and configMapKeyRef",
+			params: []Param{{Name: "myparam", ValueFrom: &ParamSource{
+				SecretKeyRef:    &corev1.SecretKeySelector{Key: "mykey"},
+				ConfigMapKeyRef: &corev1.ConfigMapKeySelector{Key: "mykey"},
+			}}},
+			expectErr: `Service.ibmcloud.ibm.com "myservice" is invalid: spec.parameters[0].valueFrom: Invalid value: "myparam": secretKeyRef and configMapKeyRef are mutually exclusive`,
+		},
+		{
+			description:       "allowed binding namespaces",
+			allowedNamespaces: []string{"app1"},
+			allowedSelector:   &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}},
+		},
+		{
+			description:     "empty allowed binding namespace selector",
+			allowedSelector: &metav1.LabelSelector{},
+			expectErr:       `Service.ibmcloud.ibm.com "myservice" is invalid: spec.allowedBindingNamespaceSelector.matchLabels: Required value: matchLabels or matchExpressions are required, since an empty selector matches every namespace`,
+		},
+		{
+			description:       "invalid allowed binding namespaces",
+			allowedNamespaces: []string{"Not_A_Namespace"},
+			allowedSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+				{Key: "team", Operator: metav1.LabelSelectorOpExists, Values: []string{"a"}},
+			}},
+			expectErr: `Service.ibmcloud.ibm.com "myservice" is invalid: [spec.allowedBindingNamespaces[0]: Invalid value: "Not_A_Namespace": a DNS-1123 label must consist of lower case alphanumeric characters or '-', and must start and end with an alphanumeric character (e.g. 'my-name',  or '123-abc', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?'), spec.allowedBindingNamespaceSelector.matchExpressions[0].values: Forbidden: may not be specified when ` + "`operator`" + ` is 'Exists' or 'DoesNotExist']`,
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			service := &Service{
+				ObjectMeta: metav1.ObjectMeta{Name: "myservice"},
+				Spec: ServiceSpec{
+					ServiceClass:                    "myclass",
+					Plan:                            "Lite",
+					Parameters:                      tc.params,
+					AllowedBindingNamespaces:        tc.allowedNamespaces,
+					AllowedBindingNamespaceSelector: tc.allowedSelector,
+				},
+			}
+			err := service.ValidateCreate()
+			if tc.expectErr != "" {
+				assert.EqualError(t, err, tc.expectErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestServiceValidateAdoptInstanceID(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		description string
+		spec        ServiceSpec
+		expectErr   string
+	}{
+		{
+			description: "regular plan",
+			spec:        ServiceSpec{ServiceClass: "myclass", Plan: "Lite", AdoptInstanceID: "myinstanceid"},
+		},
+		{
+			description: "alias plan",
+			spec:        ServiceSpec{ServiceClass: "myclass", Plan: "Alias", AdoptInstanceID: "myinstanceid"},
+			expectErr:   `Service.ibmcloud.ibm.com "myservice" is invalid: spec.adoptInstanceID: Forbidden: cannot be set with the Alias plan, use the ibmcloud.ibm.com/instanceId annotation instead`,
+		},
+		{
+			description: "observe policy",
+			spec:        ServiceSpec{ServiceClass: "myclass", Plan: "Lite", AdoptInstanceID: "myinstanceid", ManagementPolicy: ManagementPolicyObserve},
+			expectErr:   `Service.ibmcloud.ibm.com "myservice" is invalid: spec.adoptInstanceID: Forbidden: cannot be set with the Observe management policy, use the ibmcloud.ibm.com/instanceId annotation instead`,
+		},
+		{
+			description: "CF service",
+			spec:        ServiceSpec{ServiceClass: "myclass", ServiceClassType: "CF", Plan: "Lite", AdoptInstanceID: "myinstanceid"},
+			expectErr:   `Service.ibmcloud.ibm.com "myservice" is invalid: spec.adoptInstanceID: Forbidden: CF service instances cannot be adopted`,
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			service := &Service{ObjectMeta: metav1.ObjectMeta{Name: "myservice"}, Spec: tc.spec}
+			err := service.ValidateCreate()
+			if tc.expectErr != "" {
+				assert.EqualError(t, err, tc.expectErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestServiceValidateUpdate(t *testing.T) {
+	t.Parallel()
+	provisioned := Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "myservice"},
+		Spec: ServiceSpec{
+			ServiceClass: "myclass",
+			Plan:         "Lite",
+			ExternalName: "myname",
+			Context:      ResourceContext{Region: "us-south"},
+		},
+		Status: ServiceStatus{
+			ServiceClass: "myclass",
+			Plan:         "Lite",
+			ExternalName: "myname",
+			Context:      ResourceContext{Region: "us-south"},
+		},
+	}
+	now := metav1.Now()
+
+	for _, tc := range []struct {
+		description string
+		old         Service
+		update      func(*Service)
+		expectErr   string
+	}{
+		{
+			description: "tags changed",
+			old:         provisioned,
+			update:      func(s *Service) { s.Spec.Tags = []string{"mytag"} },
+		},
+		{
+			description: "plan changed",
+			old:         provisioned,
+			update:      func(s *Service) { s.Spec.Plan = "standard" },
+		},
+		{
+			description: "CF plan changed",
+			old: func() Service {
+				s := *provisioned.DeepCopy()
+				s.Spec.ServiceClassType = "CF"
+				s.Status.ServiceClassType = "CF"
+				return s
+			}(),
+			update:    func(s *Service) { s.Spec.Plan = "standard" },
+			expectErr: `Service.ibmcloud.ibm.com "myservice" is invalid: spec.plan: Forbidden: field is immutable`,
+		},
+		{
+			description: "external name changed",
+			old:         provisioned,
+			update:      func(s *Service) { s.Spec.ExternalName = "othername" },
+		},
+		{
+			description: "CF external name changed",
+			old: func() Service {
+				s := *provisioned.DeepCopy()
+				s.Spec.ServiceClassType = "CF"
+				s.Status.ServiceClassType = "CF"
+				return s
+			}(),
+			update:    func(s *Service) { s.Spec.ExternalName = "othername" },
+			expectErr: `Service.ibmcloud.ibm.com "myservice" is invalid: spec.externalName: Forbidden: field is immutable`,
+		},
+		{
+			description: "plan changed to alias",
+			old:         provisioned,
+			update:      func(s *Service) { s.Spec.Plan = "Alias" },
+			expectErr:   `Service.ibmcloud.ibm.com "myservice" is invalid: spec.plan: Forbidden: field is immutable`,
+		},
+		{
+			description: "service class and context changed",
+			old:         provisioned,
+			update: func(s *Service) {
+				s.Spec.ServiceClass = "otherclass"
+				s.Spec.Context.Region = "eu-de"
+			},
+			expectErr: `Service.ibmcloud.ibm.com "myservice" is invalid: [spec.serviceClass: Forbidden: field is immutable, spec.context: Forbidden: field is immutable]`,
+		},
+		{
+			description: "plan changed before provisioning",
+			old: Service{
+				ObjectMeta: metav1.ObjectMeta{Name: "myservice"},
+				Spec:       ServiceSpec{ServiceClass: "myclass", Plan: "Lite"},
+			},
+			update: func(s *Service) { s.Spec.Plan = "standard" },
+		},
+		{
+			description: "context restored from status",
+			old: func() Service {
+				s := *provisioned.DeepCopy()
+				s.Spec.Context = ResourceContext{}
+				return s
+			}(),
+			update: func(s *Service) { s.Spec.Context = s.Status.Context },
+		},
+		{
+			description: "plan changed while deleting",
+			old:         provisioned,
+			update: func(s *Service) {
+				s.DeletionTimestamp = &now
+				s.Spec.Plan = "standard"
+			},
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			service := tc.old.DeepCopy()
+			tc.update(service)
+			err := service.ValidateUpdate(&tc.old)
+			if tc.expectErr != "" {
+				assert.EqualError(t, err, tc.expectErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package v1alpha1
+
+import (
+	"testing"
+
+	ibmcloudv1 "github.com/ibm/cloud-operators/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestServiceConversionKeepsResourceGroup(t *testing.T) {
+	t.Parallel()
+	spoke := &Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "myservice", Namespace: "mynamespace"},
+		Spec: ServiceSpec{
+			ServiceClass: "service-name",
+			Plan:         "Lite",
+			Context:      ResourceContext{ResourceGroup: "default", ResourceGroupID: "mygroup"},
+		},
+		Status: ServiceStatus{
+			State:   "Online",
+			Context: ResourceContext{ResourceGroup: "default", ResourceGroupID: "mygroup"},
+		},
+	}
+
+	hub := &ibmcloudv1.Service{}
+	require.NoError(t, spoke.ConvertTo(hub))
+	assert.Equal(t, "mygroup", hub.Spec.Context.ResourceGroupID)
+	assert.Contains(t, hub.Annotations, ibmcloudv1.ConversionDataAnnotation)
+
+	result := &Service{}
+	require.NoError(t, result.ConvertFrom(hub))
+	assert.Equal(t, spoke.Spec, result.Spec)
+	assert.Equal(t, spoke.Status, result.Status)
+}
+
+func TestServiceConversionStoresOnlyResourceGroup(t *testing.T) {
+	t.Parallel()
+	spoke := &Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "myservice", Namespace: "mynamespace"},
+		Spec: ServiceSpec{
+			ServiceClass: "service-name",
+			Plan:         "Lite",
+			Parameters:   []Param{{Name: "password", Value: &ParamValue{RawMessage: []byte(`"hunter2"`)}}},
+			Context:      ResourceContext{ResourceGroup: "default"},
+		},
+		Status: ServiceStatus{
+			Parameters: []Param{{Name: "password", Value: &ParamValue{RawMessage: []byte(`"hunter2"`)}}},
+			Context:    ResourceContext{ResourceGroup: "default"},
+		},
+	}
+
+	hub := &ibmcloudv1.Service{}
+	require.NoError(t, spoke.ConvertTo(hub))
+	assert.JSONEq(t,
+		`{"spec":{"context":{"resourcegroup":"default"}},"status":{"context":{"resourcegroup":"default"}}}`,
+		hub.Annotations[ibmcloudv1.ConversionDataAnnotation],
+	)
+}
+
+func TestServiceConversionWithoutResourceGroup(t *testing.T) {
+	t.Parallel()
+	spoke := &Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "myservice"},
+		Spec:       ServiceSpec{ServiceClass: "service-name", Plan: "Lite"},
+	}
+
+	hub := &ibmcloudv1.Service{}
+	require.NoError(t, spoke.ConvertTo(hub))
+	assert.Nil(t, hub.Annotations)
+	assert.Equal(t, ibmcloudv1.ServiceSpec{ServiceClass: "service-name", Plan: "Lite"}, hub.Spec)
+}
+
+func TestBindingConversionRoundTrip(t *testing.T) {
+	t.Parallel()
+	hub := &ibmcloudv1.Binding{
+		ObjectMeta: metav1.ObjectMeta{Name: "mybinding", Namespace: "mynamespace"},
+		Spec: ibmcloudv1.BindingSpec{
+			ServiceName: "myservice",
+			Alias:       "existing-credentials",
+		},
+		Status: ibmcloudv1.BindingStatus{
+			State:              "Online",
+			KeyInstanceID:      "mykey",
+			ObservedGeneration: 3,
+		},
+	}
+
+	spoke := &Binding{}
+	require.NoError(t, spoke.ConvertFrom(hub))
+	result := &ibmcloudv1.Binding{}
+	require.NoError(t, spoke.ConvertTo(result))
+	assert.Equal(t, hub, result)
+}
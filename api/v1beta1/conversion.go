@@ -0,0 +1,42 @@
+/*
+ * Copyright 2021 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1beta1
+
+import (
+	ibmcloudv1 "github.com/ibm/cloud-operators/api/v1"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+// ConvertTo converts this Service to the Hub version (v1)
+func (src *Service) ConvertTo(dst conversion.Hub) error {
+	return ibmcloudv1.Convert(src, dst)
+}
+
+// ConvertFrom converts from the Hub version (v1) to this version
+func (dst *Service) ConvertFrom(src conversion.Hub) error {
+	return ibmcloudv1.Convert(src, dst)
+}
+
+// ConvertTo converts this Binding to the Hub version (v1)
+func (src *Binding) ConvertTo(dst conversion.Hub) error {
+	return ibmcloudv1.Convert(src, dst)
+}
+
+// ConvertFrom converts from the Hub version (v1) to this version
+func (dst *Binding) ConvertFrom(src conversion.Hub) error {
+	return ibmcloudv1.Convert(src, dst)
+}
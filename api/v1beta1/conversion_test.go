@@ -0,0 +1,117 @@
+package v1beta1
+
+import (
+	"testing"
+	"time"
+
+	ibmcloudv1 "github.com/ibm/cloud-operators/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var conversionTime = metav1.NewTime(time.Date(2021, time.January, 1, 0, 0, 0, 0, time.Local))
+
+func TestServiceConversionRoundTrip(t *testing.T) {
+	t.Parallel()
+	hub := &ibmcloudv1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "myservice",
+			Namespace:   "mynamespace",
+			Annotations: map[string]string{"some-annotation": "some-value"},
+		},
+		Spec: ibmcloudv1.ServiceSpec{
+			ServiceClass: "service-name",
+			Plan:         "Lite",
+			ExternalName: "myservice-external",
+			Parameters: []ibmcloudv1.Param{
+				{Name: "hello", Value: &ibmcloudv1.ParamValue{RawMessage: []byte(`"world"`)}},
+				{Name: "secret", ValueFrom: &ibmcloudv1.ParamSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "mysecret"},
+						Key:                  "mykey",
+					},
+				}},
+			},
+			Tags:    []string{"a", "b"},
+			Context: ibmcloudv1.ResourceContext{Region: "us-south", ResourceGroupID: "mygroup"},
+		},
+		Status: ibmcloudv1.ServiceStatus{
+			State:              "Online",
+			Message:            "Online",
+			ServiceClass:       "service-name",
+			Plan:               "Lite",
+			InstanceID:         "myinstance",
+			Context:            ibmcloudv1.ResourceContext{Region: "us-south", ResourceGroupID: "mygroup"},
+			DashboardURL:       "https://example.com",
+			ObservedGeneration: 2,
+			Conditions: []ibmcloudv1.Condition{
+				{Type: ibmcloudv1.ConditionReady, Status: corev1.ConditionTrue, LastTransitionTime: conversionTime, Reason: "Online"},
+			},
+		},
+	}
+
+	spoke := &Service{}
+	require.NoError(t, spoke.ConvertFrom(hub))
+	assert.Equal(t, "Lite", spoke.Spec.Plan)
+	assert.Equal(t, "mygroup", spoke.Status.Context.ResourceGroupID)
+	assert.Contains(t, spoke.Annotations, ibmcloudv1.ConversionDataAnnotation)
+
+	result := &ibmcloudv1.Service{}
+	require.NoError(t, spoke.ConvertTo(result))
+	assert.Equal(t, hub, result)
+}
+
+func TestServiceConversionSpokeChangesWin(t *testing.T) {
+	t.Parallel()
+	hub := &ibmcloudv1.Service{
+		Spec: ibmcloudv1.ServiceSpec{ServiceClass: "service-name", Plan: "Lite"},
+		Status: ibmcloudv1.ServiceStatus{
+			ObservedGeneration: 1,
+		},
+	}
+	spoke := &Service{}
+	require.NoError(t, spoke.ConvertFrom(hub))
+
+	spoke.Spec.Plan = "standard"
+	result := &ibmcloudv1.Service{}
+	require.NoError(t, spoke.ConvertTo(result))
+	assert.Equal(t, "standard", result.Spec.Plan)
+	assert.Equal(t, int64(1), result.Status.ObservedGeneration)
+	assert.Nil(t, result.Annotations)
+}
+
+func TestBindingConversionRoundTrip(t *testing.T) {
+	t.Parallel()
+	hub := &ibmcloudv1.Binding{
+		ObjectMeta: metav1.ObjectMeta{Name: "mybinding", Namespace: "mynamespace"},
+		Spec: ibmcloudv1.BindingSpec{
+			ServiceName:      "myservice",
+			ServiceNamespace: "othernamespace",
+			SecretName:       "mysecret",
+			Role:             "Writer",
+			Parameters: []ibmcloudv1.Param{
+				{Name: "hello", Value: &ibmcloudv1.ParamValue{RawMessage: []byte(`{"a":1}`)}},
+			},
+		},
+		Status: ibmcloudv1.BindingStatus{
+			State:              "Online",
+			InstanceID:         "myinstance",
+			KeyInstanceID:      "mykey",
+			SecretName:         "mysecret",
+			ObservedGeneration: 3,
+			Conditions: []ibmcloudv1.Condition{
+				{Type: ibmcloudv1.ConditionCredentialsSynced, Status: corev1.ConditionTrue, LastTransitionTime: conversionTime, Reason: "Synced"},
+			},
+		},
+	}
+
+	spoke := &Binding{}
+	require.NoError(t, spoke.ConvertFrom(hub))
+	assert.Equal(t, "Writer", spoke.Spec.Role)
+
+	result := &ibmcloudv1.Binding{}
+	require.NoError(t, spoke.ConvertTo(result))
+	assert.Equal(t, hub, result)
+}
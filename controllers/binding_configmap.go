@@ -0,0 +1,115 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	ibmcloudv1 "github.com/ibm/cloud-operators/api/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// configMapData returns the non-sensitive credentials listed in the Binding's ConfigMapKeys
+func configMapData(instance *ibmcloudv1.Binding, keyContents map[string]interface{}) (map[string]string, error) {
+	data, err := credentialsData(instance, keyContents)
+	if err != nil {
+		return nil, err
+	}
+	ret := make(map[string]string, len(instance.Spec.ConfigMapKeys))
+	for _, key := range instance.Spec.ConfigMapKeys {
+		value, ok := data[key]
+		if !ok {
+			return nil, fmt.Errorf("credentials do not contain configMapKeys entry %q", key)
+		}
+		ret[key] = string(value)
+	}
+	return ret, nil
+}
+
+// syncConfigMap creates or updates the Binding's ConfigMap of non-sensitive credentials, or deletes it if no keys are listed
+func (r *BindingReconciler) syncConfigMap(ctx context.Context, instance *ibmcloudv1.Binding, keyContents map[string]interface{}) error {
+	if len(instance.Spec.ConfigMapKeys) == 0 {
+		if instance.Status.ConfigMapName == "" {
+			return nil
+		}
+		if err := r.deleteConfigMap(ctx, instance); err != nil {
+			return err
+		}
+		instance.Status.ConfigMapName = ""
+		return nil
+	}
+
+	data, err := configMapData(instance, keyContents)
+	if err != nil {
+		return err
+	}
+	name := getSecretName(instance)
+	if instance.Status.ConfigMapName != "" && instance.Status.ConfigMapName != name {
+		// the secret was renamed, so remove the ConfigMap with the old name
+		if err := r.deleteConfigMap(ctx, instance); err != nil {
+			return err
+		}
+	}
+	annotations := map[string]string{
+		"service-instance-id": instance.Status.InstanceID,
+		"service-key-id":      instance.Status.KeyInstanceID,
+		"bindingFromName":     instance.Spec.ServiceName,
+	}
+
+	existing := &corev1.ConfigMap{}
+	err = r.Get(ctx, types.NamespacedName{Name: name, Namespace: instance.Namespace}, existing)
+	switch {
+	case errors.IsNotFound(err):
+		r.Log.Info("Creating ", "configmap", name)
+		configMap := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Namespace:   instance.Namespace,
+				Annotations: annotations,
+			},
+			Data: data,
+		}
+		if err := r.SetControllerReference(instance, configMap, r.Scheme); err != nil {
+			return err
+		}
+		if err := r.Create(ctx, configMap); err != nil {
+			return err
+		}
+	case err != nil:
+		return err
+	case !metav1.IsControlledBy(existing, instance):
+		return errors.NewAlreadyExists(corev1.Resource("configmaps"), name)
+	case !reflect.DeepEqual(existing.Data, data) || !reflect.DeepEqual(existing.Annotations, annotations):
+		r.Log.Info("Updating ", "configmap", name)
+		existing.Annotations = annotations
+		existing.Data = data
+		if err := r.Update(ctx, existing); err != nil {
+			return err
+		}
+	}
+	instance.Status.ConfigMapName = name
+	return nil
+}
+
+// deleteConfigMap deletes the ConfigMap recorded in the Binding's status, if it still exists
+func (r *BindingReconciler) deleteConfigMap(ctx context.Context, instance *ibmcloudv1.Binding) error {
+	existing := &corev1.ConfigMap{}
+	err := r.Get(ctx, types.NamespacedName{Name: instance.Status.ConfigMapName, Namespace: instance.Namespace}, existing)
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !metav1.IsControlledBy(existing, instance) {
+		return nil
+	}
+	r.Log.Info("Deleting ", "configmap", existing.Name)
+	if err := r.Delete(ctx, existing); err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	ibmcloudv1 "github.com/ibm/cloud-operators/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+func TestBindingSplitCredentials(t *testing.T) {
+	t.Parallel()
+	keyContents := map[string]interface{}{
+		"apikey":   "myapikey",
+		"endpoint": "https://example.com",
+		"region":   "us-south",
+	}
+	binding := &ibmcloudv1.Binding{
+		Spec: ibmcloudv1.BindingSpec{ConfigMapKeys: []string{"endpoint", "region"}},
+	}
+
+	data, err := secretData(binding, keyContents)
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"apikey": []byte("myapikey")}, data)
+
+	configData, err := configMapData(binding, keyContents)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"endpoint": "https://example.com", "region": "us-south"}, configData)
+
+	binding.Spec.ConfigMapKeys = []string{"url"}
+	_, err = configMapData(binding, keyContents)
+	assert.EqualError(t, err, `credentials do not contain configMapKeys entry "url"`)
+}
+
+func TestBindingSyncConfigMap(t *testing.T) {
+	t.Parallel()
+	const (
+		namespace   = "mynamespace"
+		bindingName = "mybinding"
+	)
+	keyContents := map[string]interface{}{
+		"apikey":   "myapikey",
+		"endpoint": "https://example.com",
+	}
+	ownerBinding := &ibmcloudv1.Binding{
+		ObjectMeta: metav1.ObjectMeta{Name: bindingName, Namespace: namespace, UID: "binding-uid"},
+	}
+
+	for _, tc := range []struct {
+		description   string
+		configMapKeys []string
+		statusName    string
+		existing      func(t *testing.T, scheme *runtime.Scheme) *corev1.ConfigMap
+		expectErr     string
+		expectData    map[string]string
+		expectStatus  string
+	}{
+		{
+			description: "no keys",
+		},
+		{
+			description:   "create",
+			configMapKeys: []string{"endpoint"},
+			expectData:    map[string]string{"endpoint": "https://example.com"},
+			expectStatus:  bindingName,
+		},
+		{
+			description:   "update",
+			configMapKeys: []string{"endpoint"},
+			statusName:    bindingName,
+			existing: func(t *testing.T, scheme *runtime.Scheme) *corev1.ConfigMap {
+				configMap := &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: bindingName, Namespace: namespace},
+					Data:       map[string]string{"endpoint": "https://old.example.com"},
+				}
+				require.NoError(t, controllerutil.SetControllerReference(ownerBinding, configMap, scheme))
+				return configMap
+			},
+			expectData:   map[string]string{"endpoint": "https://example.com"},
+			expectStatus: bindingName,
+		},
+		{
+			description: "delete when keys removed",
+			statusName:  bindingName,
+			existing: func(t *testing.T, scheme *runtime.Scheme) *corev1.ConfigMap {
+				configMap := &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: bindingName, Namespace: namespace},
+					Data:       map[string]string{"endpoint": "https://example.com"},
+				}
+				require.NoError(t, controllerutil.SetControllerReference(ownerBinding, configMap, scheme))
+				return configMap
+			},
+		},
+		{
+			description:   "existing configmap not owned by binding",
+			configMapKeys: []string{"endpoint"},
+			existing: func(t *testing.T, scheme *runtime.Scheme) *corev1.ConfigMap {
+				return &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: bindingName, Namespace: namespace},
+					Data:       map[string]string{"other": "data"},
+				}
+			},
+			expectErr: `configmaps "mybinding" already exists`,
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			scheme := schemas(t)
+			var objects []runtime.Object
+			if tc.existing != nil {
+				objects = append(objects, tc.existing(t, scheme))
+			}
+			r := &BindingReconciler{
+				Client:                 fake.NewFakeClientWithScheme(scheme, objects...),
+				Log:                    testLogger(t),
+				Scheme:                 scheme,
+				SetControllerReference: controllerutil.SetControllerReference,
+			}
+			binding := ownerBinding.DeepCopy()
+			binding.Spec = ibmcloudv1.BindingSpec{ServiceName: "myservice", ConfigMapKeys: tc.configMapKeys}
+			binding.Status = ibmcloudv1.BindingStatus{InstanceID: "myinstance", KeyInstanceID: "mykey", ConfigMapName: tc.statusName}
+
+			err := r.syncConfigMap(context.Background(), binding, keyContents)
+			if tc.expectErr != "" {
+				assert.EqualError(t, err, tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectStatus, binding.Status.ConfigMapName)
+
+			var configMap corev1.ConfigMap
+			err = r.Get(context.Background(), types.NamespacedName{Name: bindingName, Namespace: namespace}, &configMap)
+			if tc.expectData == nil {
+				assert.Error(t, err, "ConfigMap should not exist")
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectData, configMap.Data)
+			assert.Equal(t, "mykey", configMap.Annotations["service-key-id"])
+			assert.True(t, metav1.IsControlledBy(&configMap, binding))
+		})
+	}
+}
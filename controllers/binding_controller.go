@@ -24,30 +24,37 @@ import (
 	"strings"
 	"time"
 
+	"github.com/IBM-Cloud/bluemix-go/crn"
 	"github.com/IBM-Cloud/bluemix-go/session"
 	"github.com/go-logr/logr"
 	ibmcloudv1 "github.com/ibm/cloud-operators/api/v1"
+	"github.com/ibm/cloud-operators/internal/certificates"
+	"github.com/ibm/cloud-operators/internal/clouddatabases"
 	"github.com/ibm/cloud-operators/internal/config"
 	"github.com/ibm/cloud-operators/internal/ibmcloud"
 	"github.com/ibm/cloud-operators/internal/ibmcloud/cfservice"
 	"github.com/ibm/cloud-operators/internal/ibmcloud/iam"
 	"github.com/ibm/cloud-operators/internal/ibmcloud/resource"
+	"github.com/ibm/cloud-operators/internal/secrettemplate"
+	"github.com/ibm/cloud-operators/internal/servicebinding"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/retry"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 )
 
 const (
 	bindingFinalizer = "binding.ibmcloud.ibm.com"
 	inProgress       = "IN PROGRESS"
 	notFound         = "Not Found"
-	idkey            = "ibmcloud.ibm.com/keyId"
+	idkey            = ibmcloudv1.KeyIDAnnotation
 	requeueFast      = 10 * time.Second
 )
 
@@ -63,8 +70,19 @@ const (
 // BindingReconciler reconciles a Binding object
 type BindingReconciler struct {
 	client.Client
-	Log    logr.Logger
-	Scheme *runtime.Scheme
+	Log      logr.Logger
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+	// APIReader reads workloads to restart directly from the API server, so the cache does not watch them in every namespace
+	APIReader client.Reader
+	// StrictNamespaces disables falling back to the default namespace for referenced secrets and configmaps
+	StrictNamespaces bool
+	// InstanceCRNNamespaces are the namespaces whose Bindings may bind to an instance by CRN, without a Service
+	InstanceCRNNamespaces []string
+	// HashKey is the operator's key for the hashes of resolved parameters and secret contents recorded in statuses and annotations
+	HashKey *HashKey
+	// ParamSources watches the secrets and configmaps read by parameters, shared with the other controllers so each is watched once
+	ParamSources *ParamSources
 
 	CreateResourceServiceKey   resource.KeyCreator
 	CreateCFServiceKey         cfservice.KeyCreator
@@ -82,12 +100,18 @@ type BindingReconciler struct {
 
 type OwnerReferenceSetter func(owner, owned metav1.Object, scheme *runtime.Scheme) error
 
-type IBMCloudInfoGetter func(logt logr.Logger, r client.Client, instance *ibmcloudv1.Service) (*ibmcloud.Info, error)
+type IBMCloudInfoGetter func(logt logr.Logger, r client.Client, instance *ibmcloudv1.Service, strictNamespaces bool) (*ibmcloud.Info, error)
 
 func (r *BindingReconciler) SetupWithManager(mgr ctrl.Manager, options controller.Options) error {
+	secrets, configMaps, err := r.ParamSources.Watches(mgr)
+	if err != nil {
+		return err
+	}
 	return ctrl.NewControllerManagedBy(mgr).
 		WithOptions(options).
 		For(&ibmcloudv1.Binding{}).
+		Watches(secrets, &handler.EnqueueRequestsFromMapFunc{ToRequests: r.requestsForParamSource(secretParamSource)}).
+		Watches(configMaps, &handler.EnqueueRequestsFromMapFunc{ToRequests: r.requestsForParamSource(configMapParamSource)}).
 		Complete(r)
 }
 
@@ -123,6 +147,7 @@ func (r *BindingReconciler) Reconcile(request ctrl.Request) (ctrl.Result, error)
 	if reflect.DeepEqual(instance.Status, ibmcloudv1.BindingStatus{}) {
 		instance.Status.State = bindingStatePending
 		instance.Status.Message = "Processing Resource"
+		setBindingConditions(instance)
 		if err := r.Status().Update(ctx, instance); err != nil {
 			logt.Info("Binding could not update Status", instance.Name, err.Error())
 			// TODO(johnstarich): Shouldn't this be a failure so it can be requeued?
@@ -140,6 +165,11 @@ func (r *BindingReconciler) Reconcile(request ctrl.Request) (ctrl.Result, error)
 			// In this case it is enough to simply remove the finalizer:
 			// the credentials do not exist on the cloud, since the service cannot be found.
 			// Also by removing the Binding instance, any correponding secret will also be deleted by Kubernetes.
+			// Secret copies in other namespaces are not owned by the Binding, so delete them here.
+			if err := r.deleteSecretCopies(ctx, instance, getSecretName(instance), instance.Status.TargetNamespaces); err != nil {
+				logt.Info("Error deleting secret copies", "in deletion", err.Error())
+				return ctrl.Result{Requeue: true, RequeueAfter: requeueFast}, nil
+			}
 			instance.ObjectMeta.Finalizers = deleteBindingFinalizer(instance)
 			if err := r.Update(ctx, instance); err != nil {
 				logt.Info("Error removing finalizers", "in deletion", err.Error())
@@ -157,7 +187,7 @@ func (r *BindingReconciler) Reconcile(request ctrl.Request) (ctrl.Result, error)
 	}
 
 	// Set an owner reference if service and binding are in the same namespace
-	if serviceInstance.Namespace == instance.Namespace {
+	if instance.Spec.InstanceCRN == "" && serviceInstance.Namespace == instance.Namespace {
 		if err := r.SetOwnerReference(serviceInstance, instance, r.Scheme); err != nil {
 			logt.Info("Binding could not update owner reference", instance.Name, err.Error())
 			return ctrl.Result{}, err
@@ -179,12 +209,16 @@ func (r *BindingReconciler) Reconcile(request ctrl.Request) (ctrl.Result, error)
 	var serviceClassType string
 	var session *session.Session
 	{
-		ibmCloudInfo, err := r.GetIBMCloudInfo(logt, r.Client, serviceInstance)
+		ibmCloudInfo, err := r.GetIBMCloudInfo(logt, r.Client, serviceInstance, r.StrictNamespaces)
 		if err != nil {
 			logt.Info("Unable to get IBM Cloud info", "ibmcloudInfo", instance.Name)
 			if errors.IsNotFound(err) && containsBindingFinalizer(instance) &&
 				!instance.ObjectMeta.DeletionTimestamp.IsZero() {
 				logt.Info("Cannot get IBMCloud related secrets and configmaps, just remove finalizers", "in deletion", err.Error())
+				if err := r.deleteSecretCopies(ctx, instance, getSecretName(instance), instance.Status.TargetNamespaces); err != nil {
+					logt.Info("Error deleting secret copies", "in deletion", err.Error())
+					return ctrl.Result{Requeue: true, RequeueAfter: requeueFast}, nil
+				}
 				instance.ObjectMeta.Finalizers = deleteBindingFinalizer(instance)
 				if err := r.Update(ctx, instance); err != nil {
 					logt.Info("Error removing finalizers", "in deletion", err.Error())
@@ -212,6 +246,13 @@ func (r *BindingReconciler) Reconcile(request ctrl.Request) (ctrl.Result, error)
 		// The object is being deleted
 		if containsBindingFinalizer(instance) {
 			logt.Info("Resource marked for deletion", "in deletion", instance.Name)
+			if !ibmcloudv1.IsConditionTrue(instance.Status.Conditions, ibmcloudv1.ConditionDeleting) {
+				setBindingConditions(instance)
+				if err := r.Status().Update(ctx, instance); err != nil {
+					logt.Info("Error setting deleting condition", "in deletion", err.Error())
+					return ctrl.Result{}, err
+				}
+			}
 			err := r.deleteCredentials(session, instance, serviceClassType)
 			if err != nil {
 				logt.Info("Error deleting credentials", "in deletion", err.Error())
@@ -227,6 +268,16 @@ func (r *BindingReconciler) Reconcile(request ctrl.Request) (ctrl.Result, error)
 		}
 	}
 
+	// Bindings from other namespaces must be allowed by the Service before any credentials are created or synced
+	if err := r.checkBindingAllowed(ctx, instance, serviceInstance); err != nil {
+		logt.Info("Binding not allowed", instance.Name, err.Error())
+		return r.updateStatusError(instance, bindingStateFailed, err)
+	}
+	if err := checkRotationSupported(instance, serviceClassType); err != nil {
+		logt.Info("Rotation not supported", instance.Name, err.Error())
+		return r.updateStatusError(instance, bindingStateFailed, err)
+	}
+
 	if instance.Status.InstanceID == "" { // The service Instance ID has not been initialized yet
 		instance.Status.InstanceID = serviceInstance.Status.InstanceID
 
@@ -245,6 +296,7 @@ func (r *BindingReconciler) Reconcile(request ctrl.Request) (ctrl.Result, error)
 	// Now instance.Status.IntanceID has been set properly
 	if instance.Status.KeyInstanceID == "" { // The KeyInstanceID has not been set, need to create the key
 		instance.Status.KeyInstanceID = inProgress
+		setBindingConditions(instance)
 		if err := r.Status().Update(ctx, instance); err != nil {
 			logt.Info("Error updating KeyInstanceID to be in progress", "Error", err.Error())
 			// TODO(johnstarich): Shouldn't this be a failure so it can be requeued?
@@ -271,15 +323,29 @@ func (r *BindingReconciler) Reconcile(request ctrl.Request) (ctrl.Result, error)
 			}
 		}
 		instance.Status.KeyInstanceID = keyInstanceID
+		additionalCredentials, err := r.syncAdditionalCredentials(ctx, session, instance, serviceClassType)
+		if err != nil {
+			logt.Info("Error syncing additional credentials", instance.Name, err.Error())
+			return r.updateStatusError(instance, bindingStateFailed, err)
+		}
+		keyContents = withAdditionalCredentials(bindingCredentials(instance, serviceInstance, keyContents), additionalCredentials)
 
 		// Now create the secret
-		err = r.createSecret(instance, keyContents)
+		err = r.createSecret(ctx, instance, keyContents)
 
 		if err != nil {
 			logt.Info("Error creating secret", instance.Name, err.Error())
 			return r.updateStatusError(instance, bindingStateFailed, err)
 		}
 
+		if err := r.syncConfigMap(ctx, instance, keyContents); err != nil {
+			logt.Info("Error syncing configmap", instance.Name, err.Error())
+			return r.updateStatusError(instance, bindingStateFailed, err)
+		}
+		if err := r.replicateSecret(ctx, instance, keyContents); err != nil {
+			logt.Info("Error copying secret to target namespaces", instance.Name, err.Error())
+			return r.updateStatusError(instance, bindingStateFailed, err)
+		}
 		return r.updateStatusOnline(session, instance)
 	}
 
@@ -306,43 +372,85 @@ func (r *BindingReconciler) Reconcile(request ctrl.Request) (ctrl.Result, error)
 		} else if err != nil {
 			logt.Error(err, "Failed to fetch credentials") // TODO(johnstarich): should this fail and requeue?
 		}
+
+		if serviceClassType != "CF" {
+			if previousCredentialsExpired(instance) {
+				logt.Info("Deleting rotated credentials", "KeyInstanceID", instance.Status.PreviousKeyInstanceID)
+				if err := r.deletePreviousCredentials(session, instance); err != nil {
+					logt.Error(err, "Failed to delete rotated credentials", "KeyInstanceID", instance.Status.PreviousKeyInstanceID)
+				}
+			}
+			paramsChanged, err := r.parametersChanged(ctx, instance)
+			if err != nil {
+				logt.Error(err, "Failed to resolve parameters")
+			}
+			if (rotationDue(instance) || paramsChanged) && instance.Status.PreviousKeyInstanceID == "" {
+				logt.Info("Rotating credentials", "KeyInstanceID", instance.Status.KeyInstanceID, "parameters changed", paramsChanged)
+				keyContents, err = r.rotateCredentials(ctx, session, instance)
+				if err != nil {
+					return r.updateStatusError(instance, bindingStateFailed, fmt.Errorf("failed to rotate credentials: %w", err))
+				}
+			}
+		}
 	}
+	additionalCredentials, err := r.syncAdditionalCredentials(ctx, session, instance, serviceClassType)
+	if err != nil {
+		logt.Info("Error syncing additional credentials", instance.Name, err.Error())
+		return r.updateStatusError(instance, bindingStateFailed, err)
+	}
+	keyContents = withAdditionalCredentials(bindingCredentials(instance, serviceInstance, keyContents), additionalCredentials)
 	secret, err := getSecret(r, instance)
 	if err != nil {
 		logt.Info("Secret does not exist", "Recreating", getSecretName(instance))
-		err = r.createSecret(instance, keyContents)
+		err = r.createSecret(ctx, instance, keyContents)
 		if err != nil {
 			logt.Info("Error creating secret", instance.Name, err.Error())
 			return r.updateStatusError(instance, bindingStateFailed, err)
 		}
-		return r.updateStatusOnline(session, instance)
-	}
-
-	// The secret exists, make sure it has the right content
-	changed, err := keyContentsChanged(keyContents, secret)
-	if err != nil {
-		logt.Info("Error checking if key contents have changed", instance.Name, err.Error())
-		return r.updateStatusError(instance, bindingStateFailed, err)
-	}
-	instanceIDMismatch := instance.Status.KeyInstanceID != secret.Annotations["service-key-id"]
-	if instanceIDMismatch || changed { // Warning: the deep comparison may not be needed, the key is probably enough
-		logt.Info("Updating secret", "key contents changed", changed, "status key ID and annotation mismatch", instanceIDMismatch)
-		err := r.deleteSecret(instance)
+		r.restartWorkloadsIfEnabled(ctx, instance, getSecretName(instance), instance.Status.SecretHash)
+	} else {
+		// The secret exists, make sure it has the right content
+		hashKey, err := r.HashKey.Get(ctx)
 		if err != nil {
-			logt.Info("Error deleting secret before recreating", instance.Name, err.Error())
 			return r.updateStatusError(instance, bindingStateFailed, err)
 		}
-		err = r.createSecret(instance, keyContents)
+		changed, err := keyContentsChanged(instance, keyContents, secret, hashKey)
 		if err != nil {
-			logt.Info("Error re-creating secret", instance.Name, err.Error())
+			logt.Info("Error checking if key contents have changed", instance.Name, err.Error())
 			return r.updateStatusError(instance, bindingStateFailed, err)
 		}
-		return r.updateStatusOnline(session, instance)
+		instanceIDMismatch := instance.Status.KeyInstanceID != secret.Annotations["service-key-id"]
+		if instanceIDMismatch || changed { // Warning: the deep comparison may not be needed, the key is probably enough
+			logt.Info("Updating secret", "key contents changed", changed, "status key ID and annotation mismatch", instanceIDMismatch)
+			previousHash := secretHash(hashKey, secret.Data)
+			err := r.updateSecret(ctx, instance, secret, keyContents)
+			if err != nil {
+				logt.Info("Error updating secret", instance.Name, err.Error())
+				return r.updateStatusError(instance, bindingStateFailed, err)
+			}
+			if previousHash != instance.Status.SecretHash {
+				r.restartWorkloadsIfEnabled(ctx, instance, getSecretName(instance), instance.Status.SecretHash)
+			}
+		} else {
+			instance.Status.SecretHash = secret.Annotations[secretHashAnnotation]
+		}
+	}
+
+	if err := r.syncConfigMap(ctx, instance, keyContents); err != nil {
+		logt.Info("Error syncing configmap", instance.Name, err.Error())
+		return r.updateStatusError(instance, bindingStateFailed, err)
+	}
+	if err := r.replicateSecret(ctx, instance, keyContents); err != nil {
+		logt.Info("Error copying secret to target namespaces", instance.Name, err.Error())
+		return r.updateStatusError(instance, bindingStateFailed, err)
 	}
 	return r.updateStatusOnline(session, instance)
 }
 
 func (r *BindingReconciler) getServiceInstance(instance *ibmcloudv1.Binding) (*ibmcloudv1.Service, error) {
+	if instance.Spec.InstanceCRN != "" {
+		return unmanagedServiceInstance(instance)
+	}
 	serviceNameSpace := instance.ObjectMeta.Namespace
 	if instance.Spec.ServiceNamespace != "" {
 		serviceNameSpace = instance.Spec.ServiceNamespace
@@ -355,6 +463,23 @@ func (r *BindingReconciler) getServiceInstance(instance *ibmcloudv1.Binding) (*i
 	return serviceInstance, nil
 }
 
+// unmanagedServiceInstance returns a Service standing in for the service instance named by the Binding's InstanceCRN,
+// so that an instance managed outside of the cluster is bound without a Service resource
+func unmanagedServiceInstance(instance *ibmcloudv1.Binding) (*ibmcloudv1.Service, error) {
+	instanceCRN, err := crn.Parse(instance.Spec.InstanceCRN)
+	if err != nil {
+		return &ibmcloudv1.Service{}, err
+	}
+	return &ibmcloudv1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: instance.Name, Namespace: instance.Namespace},
+		Spec: ibmcloudv1.ServiceSpec{
+			ServiceClass: instanceCRN.ServiceName,
+			Plan:         aliasPlan,
+		},
+		Status: ibmcloudv1.ServiceStatus{InstanceID: instance.Spec.InstanceCRN},
+	}, nil
+}
+
 func (r *BindingReconciler) resetResource(instance *ibmcloudv1.Binding) (ctrl.Result, error) {
 	instance.Status.State = bindingStatePending
 	instance.Status.Message = "Processing Resource"
@@ -363,12 +488,25 @@ func (r *BindingReconciler) resetResource(instance *ibmcloudv1.Binding) (ctrl.Re
 
 	// If a secret exists that corresponds to this Binding, then delete it
 	err := r.deleteSecret(instance)
+	if err == nil {
+		err = r.deleteSecretCopies(context.Background(), instance, getSecretName(instance), instance.Status.TargetNamespaces)
+	}
+	for _, credentials := range instance.Status.Credentials {
+		if err == nil && credentials.SecretName != "" {
+			err = r.deleteCredentialsSecret(context.Background(), instance, credentials.SecretName)
+		}
+	}
 	if err != nil {
 		r.Log.Info("Unable to delete", "secret", instance.Name)
 		return ctrl.Result{Requeue: true, RequeueAfter: config.Get().SyncPeriod}, nil
 	}
 
 	instance.Status.SecretName = ""
+	instance.Status.SecretHash = ""
+	instance.Status.Binding = nil
+	instance.Status.TargetNamespaces = nil
+	instance.Status.Credentials = nil
+	setBindingConditions(instance)
 	if err := r.Status().Update(context.Background(), instance); err != nil {
 		r.Log.Info("Binding could not reset Status", instance.Name, err.Error())
 		// TODO(johnstarich): Shouldn't this be a failure so it can be requeued?
@@ -391,6 +529,7 @@ func (r *BindingReconciler) updateStatusError(instance *ibmcloudv1.Binding, stat
 	if instance.Status.State != state {
 		instance.Status.State = state
 		instance.Status.Message = message
+		setBindingConditions(instance)
 		if err := r.Status().Update(context.Background(), instance); err != nil {
 			r.Log.Info("Error updating status", state, err.Error())
 			return ctrl.Result{}, nil
@@ -403,7 +542,9 @@ func (r *BindingReconciler) updateStatusError(instance *ibmcloudv1.Binding, stat
 func (r *BindingReconciler) deleteCredentials(session *session.Session, instance *ibmcloudv1.Binding, serviceClassType string) error {
 	r.Log.Info("Deleting", "credentials", instance.ObjectMeta.Name)
 
-	if instance.Spec.Alias == "" { // Delete only if it not alias
+	if instance.Spec.DeletionPolicy == ibmcloudv1.DeletionPolicyRetain {
+		r.Log.Info("Retaining", "credentials", instance.ObjectMeta.Name, "KeyInstanceID", instance.Status.KeyInstanceID)
+	} else if instance.Spec.Alias == "" { // Delete only if it not alias
 		if serviceClassType == "CF" { // service type is CF
 			err := r.DeleteCFServiceKey(session, instance.Status.KeyInstanceID)
 			if err != nil {
@@ -414,8 +555,19 @@ func (r *BindingReconciler) deleteCredentials(session *session.Session, instance
 			if err != nil {
 				return err
 			}
+			if instance.Status.PreviousKeyInstanceID != "" {
+				if err := r.deletePreviousCredentials(session, instance); err != nil {
+					return err
+				}
+			}
 		}
 	}
+	if err := r.deleteAdditionalCredentials(session, instance, instance.Status.Credentials); err != nil {
+		return err
+	}
+	if err := r.deleteSecretCopies(context.Background(), instance, getSecretName(instance), instance.Status.TargetNamespaces); err != nil {
+		return err
+	}
 	return r.deleteSecret(instance)
 }
 
@@ -451,19 +603,30 @@ func (r *BindingReconciler) getAliasCredentials(logt logr.Logger, session *sessi
 
 func (r *BindingReconciler) createCredentials(ctx context.Context, session *session.Session, instance *ibmcloudv1.Binding, serviceClassType string) (string, map[string]interface{}, error) {
 	r.Log.Info("Creating", "credentials", instance.ObjectMeta.Name)
-	parameters, err := r.getParams(ctx, instance)
+	parameters, err := r.getParams(ctx, instance.Spec.Parameters, instance.Namespace)
 	if err != nil {
 		r.Log.Error(err, "Instance ", instance.ObjectMeta.Name, " has problems with its parameters")
 		return "", nil, err
 	}
+	paramsHash, err := credentialsParametersHash(ctx, r.HashKey, parameters)
+	if err != nil {
+		return "", nil, err
+	}
+	var keyInstanceID string
+	var keyContents map[string]interface{}
 	if serviceClassType == "CF" { // service type is CF
-		return r.CreateCFServiceKey(session, instance.Status.InstanceID, instance.ObjectMeta.Name, parameters)
+		keyInstanceID, keyContents, err = r.CreateCFServiceKey(session, instance.Status.InstanceID, instance.ObjectMeta.Name, parameters)
+	} else { // service type is not CF
+		keyInstanceID, keyContents, err = r.getResourceServiceCredentials(session, instance, instance.ObjectMeta.Name, instance.Spec.Role, parameters)
 	}
-	// service type is not CF
-	return r.getResourceServiceCredentials(session, instance, parameters)
+	if err != nil {
+		return "", nil, err
+	}
+	instance.Status.ParametersHash = paramsHash
+	return keyInstanceID, keyContents, nil
 }
 
-func (r *BindingReconciler) getResourceServiceCredentials(session *session.Session, instance *ibmcloudv1.Binding, parameters map[string]interface{}) (string, map[string]interface{}, error) {
+func (r *BindingReconciler) getResourceServiceCredentials(session *session.Session, instance *ibmcloudv1.Binding, keyName, role string, parameters map[string]interface{}) (string, map[string]interface{}, error) {
 	instanceCRN, serviceID, err := r.GetServiceInstanceCRN(session, instance.Status.InstanceID)
 	if err != nil {
 		return "", nil, err
@@ -473,40 +636,95 @@ func (r *BindingReconciler) getResourceServiceCredentials(session *session.Sessi
 		return "", nil, err
 	}
 
-	parameters["role_crn"], err = r.GetServiceRoleCRN(session, serviceName, instance.Spec.Role)
+	parameters["role_crn"], err = r.GetServiceRoleCRN(session, serviceName, role)
 	if err != nil {
 		return "", nil, err
 	}
 
-	return r.CreateResourceServiceKey(session, instance.ObjectMeta.Name, instanceCRN, parameters)
+	return r.CreateResourceServiceKey(session, keyName, instanceCRN, parameters)
 }
 
-func (r *BindingReconciler) createSecret(instance *ibmcloudv1.Binding, keyContents map[string]interface{}) error {
+func (r *BindingReconciler) createSecret(ctx context.Context, instance *ibmcloudv1.Binding, keyContents map[string]interface{}) error {
 	r.Log.Info("Creating ", "secret", instance.ObjectMeta.Name)
-	datamap, err := processKey(keyContents)
+	hashKey, err := r.HashKey.Get(ctx)
+	if err != nil {
+		return err
+	}
+	secret, err := newSecret(instance, keyContents, hashKey)
+	if err != nil {
+		return err
+	}
+	if err := r.SetControllerReference(instance, secret, r.Scheme); err != nil {
+		return err
+	}
+	if err := r.Create(ctx, secret); err != nil {
+		return err
+	}
+	instance.Status.SecretHash = secret.Annotations[secretHashAnnotation]
+	return nil
+}
+
+// updateSecret updates the Binding's existing secret in place, so the secret never disappears from pods which use it.
+// Secret types are immutable, so a secret with a different type is recreated instead.
+func (r *BindingReconciler) updateSecret(ctx context.Context, instance *ibmcloudv1.Binding, secret *corev1.Secret, keyContents map[string]interface{}) error {
+	hashKey, err := r.HashKey.Get(ctx)
+	if err != nil {
+		return err
+	}
+	desired, err := newSecret(instance, keyContents, hashKey)
 	if err != nil {
 		return err
 	}
+	if secretTypeOrDefault(desired.Type) != secretTypeOrDefault(secret.Type) {
+		r.Log.Info("Recreating ", "secret", secret.Name, "type", desired.Type)
+		if err := r.deleteSecret(instance); err != nil {
+			return err
+		}
+		return r.createSecret(ctx, instance, keyContents)
+	}
+
+	r.Log.Info("Updating ", "secret", secret.Name)
+	if secret.Annotations == nil {
+		secret.Annotations = make(map[string]string)
+	}
+	for key, value := range desired.Annotations {
+		secret.Annotations[key] = value
+	}
+	secret.Data = desired.Data
+	if err := r.Update(ctx, secret); err != nil {
+		return err
+	}
+	instance.Status.SecretHash = desired.Annotations[secretHashAnnotation]
+	return nil
+}
 
-	secret := &corev1.Secret{
+// newSecret returns the secret holding the credentials in keyContents, annotated with their hash keyed with hashKey
+func newSecret(instance *ibmcloudv1.Binding, keyContents map[string]interface{}, hashKey []byte) (*corev1.Secret, error) {
+	datamap, err := secretData(instance, keyContents)
+	if err != nil {
+		return nil, err
+	}
+	var secretType corev1.SecretType
+	switch {
+	case instance.Spec.TLS:
+		secretType = corev1.SecretTypeTLS
+	case instance.Spec.SecretFormat == ibmcloudv1.SecretFormatServiceBinding:
+		secretType = servicebinding.SecretType(string(datamap["type"]))
+	}
+	return &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: getSecretName(instance),
 			Annotations: map[string]string{
 				"service-instance-id": instance.Status.InstanceID,
 				"service-key-id":      instance.Status.KeyInstanceID,
 				"bindingFromName":     instance.Spec.ServiceName,
+				secretHashAnnotation:  secretHash(hashKey, datamap),
 			},
 			Namespace: instance.Namespace,
 		},
+		Type: secretType,
 		Data: datamap,
-	}
-	if err := r.SetControllerReference(instance, secret, r.Scheme); err != nil {
-		return err
-	}
-	if err := r.Create(context.Background(), secret); err != nil {
-		return err
-	}
-	return nil
+	}, nil
 }
 
 func (r *BindingReconciler) updateStatusOnline(session *session.Session, instance *ibmcloudv1.Binding) (ctrl.Result, error) {
@@ -520,7 +738,19 @@ func (r *BindingReconciler) updateStatusOnline(session *session.Session, instanc
 		currentBindingInstance.Status.State = bindingStateOnline
 		currentBindingInstance.Status.Message = bindingStateOnline
 		currentBindingInstance.Status.SecretName = getSecretName(currentBindingInstance)
+		currentBindingInstance.Status.Binding = nil
+		if currentBindingInstance.Spec.SecretFormat == ibmcloudv1.SecretFormatServiceBinding {
+			currentBindingInstance.Status.Binding = &corev1.LocalObjectReference{Name: currentBindingInstance.Status.SecretName}
+		}
 		currentBindingInstance.Status.KeyInstanceID = instance.Status.KeyInstanceID
+		currentBindingInstance.Status.SecretHash = instance.Status.SecretHash
+		currentBindingInstance.Status.ParametersHash = instance.Status.ParametersHash
+		currentBindingInstance.Status.PreviousKeyInstanceID = instance.Status.PreviousKeyInstanceID
+		currentBindingInstance.Status.LastRotated = instance.Status.LastRotated
+		currentBindingInstance.Status.Credentials = instance.Status.Credentials
+		currentBindingInstance.Status.ConfigMapName = instance.Status.ConfigMapName
+		currentBindingInstance.Status.TargetNamespaces = instance.Status.TargetNamespaces
+		setBindingConditions(currentBindingInstance)
 		return r.Status().Update(context.Background(), currentBindingInstance)
 	})
 	if err != nil {
@@ -554,12 +784,22 @@ func getSecretName(instance *ibmcloudv1.Binding) string {
 	return secretName
 }
 
-func keyContentsChanged(keyContents map[string]interface{}, secret *corev1.Secret) (bool, error) {
-	newContent, err := processKey(keyContents)
+func keyContentsChanged(instance *ibmcloudv1.Binding, keyContents map[string]interface{}, secret *corev1.Secret, hashKey []byte) (bool, error) {
+	newContent, err := newSecret(instance, keyContents, hashKey)
 	if err != nil {
 		return false, err
 	}
-	return !reflect.DeepEqual(newContent, secret.Data), nil
+	return !reflect.DeepEqual(newContent.Data, secret.Data) ||
+		secretTypeOrDefault(newContent.Type) != secretTypeOrDefault(secret.Type) ||
+		newContent.Annotations[secretHashAnnotation] != secret.Annotations[secretHashAnnotation], nil
+}
+
+// secretTypeOrDefault returns the secret type, or the Opaque type set by the API server if it is empty
+func secretTypeOrDefault(secretType corev1.SecretType) corev1.SecretType {
+	if secretType == "" {
+		return corev1.SecretTypeOpaque
+	}
+	return secretType
 }
 
 func (r *BindingReconciler) deleteSecret(instance *ibmcloudv1.Binding) error {
@@ -582,11 +822,11 @@ func (r *BindingReconciler) getCFCredentials(logt logr.Logger, session *session.
 	return r.GetCFServiceKeyCredentials(session, instance.Status.InstanceID, name)
 }
 
-func (r *BindingReconciler) getParams(ctx context.Context, instance *ibmcloudv1.Binding) (map[string]interface{}, error) {
+func (r *BindingReconciler) getParams(ctx context.Context, parameters []ibmcloudv1.Param, namespace string) (map[string]interface{}, error) {
 	params := make(map[string]interface{})
 
-	for _, p := range instance.Spec.Parameters {
-		val, err := r.paramToJSON(ctx, p, instance.Namespace)
+	for _, p := range parameters {
+		val, err := r.paramToJSON(ctx, p, namespace)
 		if err != nil {
 			return params, err
 		}
@@ -595,6 +835,60 @@ func (r *BindingReconciler) getParams(ctx context.Context, instance *ibmcloudv1.
 	return params, nil
 }
 
+// bindingCredentials adds the Service Binding Specification entries to the credentials when the Binding uses that secret format
+func bindingCredentials(instance *ibmcloudv1.Binding, serviceInstance *ibmcloudv1.Service, keyContents map[string]interface{}) map[string]interface{} {
+	if instance.Spec.SecretFormat != ibmcloudv1.SecretFormatServiceBinding {
+		return keyContents
+	}
+	return servicebinding.Credentials(serviceInstance.Spec.ServiceClass, keyContents)
+}
+
+// secretData returns the secret contents for the credentials, without the keys written to the Binding's ConfigMap
+func secretData(instance *ibmcloudv1.Binding, keyContents map[string]interface{}) (map[string][]byte, error) {
+	data, err := credentialsData(instance, keyContents)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range instance.Spec.ConfigMapKeys {
+		delete(data, key)
+	}
+	return data, nil
+}
+
+// credentialsData returns every key for the credentials, rendered with the Binding's secret template if it has one.
+// Without a template, IBM Cloud Databases connection details are also flattened into their own keys.
+func credentialsData(instance *ibmcloudv1.Binding, keyContents map[string]interface{}) (map[string][]byte, error) {
+	var data map[string][]byte
+	var err error
+	if len(instance.Spec.SecretTemplate) > 0 {
+		data, err = secrettemplate.Render(instance.Spec.SecretTemplate, keyContents)
+		if err != nil {
+			return nil, err
+		}
+		if instance.Spec.SecretFormat == ibmcloudv1.SecretFormatServiceBinding {
+			servicebinding.AddEntries(data, keyContents)
+		}
+	} else {
+		data, err = processKey(keyContents)
+		if err != nil {
+			return nil, err
+		}
+		for key, value := range clouddatabases.ConnectionData(keyContents) {
+			if _, exists := data[key]; !exists {
+				data[key] = value
+			}
+		}
+	}
+
+	for _, certificate := range instance.Spec.Certificates {
+		data[certificate.Key], err = certificates.Decode(certificate.Key, certificate.JSONPath, keyContents)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return data, nil
+}
+
 func processKey(keyContents map[string]interface{}) (map[string][]byte, error) {
 	ret := make(map[string][]byte)
 	for k, v := range keyContents {
@@ -631,18 +925,19 @@ func (r *BindingReconciler) paramToJSON(ctx context.Context, p ibmcloudv1.Param,
 
 // paramValueToJSON takes a ParamSource and resolves its value
 func (r *BindingReconciler) paramValueToJSON(ctx context.Context, valueFrom ibmcloudv1.ParamSource, namespace string) (interface{}, error) {
+	fallback := !r.StrictNamespaces
 	if valueFrom.SecretKeyRef != nil {
-		data, err := getKubeSecretValue(ctx, r, r.Log, valueFrom.SecretKeyRef.Name, valueFrom.SecretKeyRef.Key, true, namespace)
+		data, err := getKubeSecretValue(ctx, r, r.Log, valueFrom.SecretKeyRef.Name, valueFrom.SecretKeyRef.Key, fallback, namespace)
 		if err != nil {
 			// Recoverable
-			return nil, fmt.Errorf("Missing secret %s", valueFrom.SecretKeyRef.Name)
+			return nil, missingRefError("secret", valueFrom.SecretKeyRef.Name, fallback, namespace)
 		}
 		return paramToJSONFromString(string(data))
 	} else if valueFrom.ConfigMapKeyRef != nil {
-		data, err := getConfigMapValue(ctx, r, r.Log, valueFrom.ConfigMapKeyRef.Name, valueFrom.ConfigMapKeyRef.Key, true, namespace)
+		data, err := getConfigMapValue(ctx, r, r.Log, valueFrom.ConfigMapKeyRef.Name, valueFrom.ConfigMapKeyRef.Key, fallback, namespace)
 		if err != nil {
 			// Recoverable
-			return nil, fmt.Errorf("Missing configmap %s", valueFrom.ConfigMapKeyRef.Name)
+			return nil, missingRefError("configmap", valueFrom.ConfigMapKeyRef.Name, fallback, namespace)
 		}
 		return paramToJSONFromString(data)
 	}
@@ -0,0 +1,271 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/IBM-Cloud/bluemix-go/session"
+	ibmcloudv1 "github.com/ibm/cloud-operators/api/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// additionalKeyName returns the IBM Cloud key name of a Binding's additional credentials
+func additionalKeyName(instance *ibmcloudv1.Binding, name string) string {
+	return instance.Name + "-" + name
+}
+
+// syncAdditionalCredentials creates or fetches each of the Binding's additional credentials and writes those with their own secret.
+// It returns the entries of the other credentials, prefixed with their name, to add to the Binding's secret.
+// Credentials removed from the spec are deleted.
+func (r *BindingReconciler) syncAdditionalCredentials(ctx context.Context, session *session.Session, instance *ibmcloudv1.Binding, serviceClassType string) (map[string]interface{}, error) {
+	if len(instance.Spec.Credentials) > 0 {
+		switch {
+		case serviceClassType == "CF":
+			return nil, fmt.Errorf("additional credentials are not supported for CF services")
+		case instance.Spec.Alias != "":
+			return nil, fmt.Errorf("additional credentials are not supported for alias credentials")
+		}
+	}
+
+	entries := make(map[string]interface{})
+	for _, credentials := range instance.Spec.Credentials {
+		status := getCredentialsStatus(instance, credentials.Name)
+		keyContents, err := r.getAdditionalCredentials(ctx, session, instance, credentials, &status)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get credentials %q: %w", credentials.Name, err)
+		}
+		setCredentialsStatus(instance, status)
+
+		if status.SecretName != "" && status.SecretName != credentials.SecretName {
+			if err := r.deleteCredentialsSecret(ctx, instance, status.SecretName); err != nil {
+				return nil, err
+			}
+			status.SecretName = ""
+			setCredentialsStatus(instance, status)
+		}
+		if credentials.SecretName == "" {
+			for key, value := range keyContents {
+				entries[credentials.Name+"_"+key] = value
+			}
+			continue
+		}
+		hash, written, err := r.syncCredentialsSecret(ctx, instance, credentials.SecretName, status.KeyInstanceID, keyContents)
+		if err != nil {
+			return nil, err
+		}
+		if written {
+			r.restartWorkloadsIfEnabled(ctx, instance, credentials.SecretName, hash)
+		}
+		status.SecretName = credentials.SecretName
+		setCredentialsStatus(instance, status)
+	}
+
+	var removed []ibmcloudv1.BindingCredentialsStatus
+	for _, status := range instance.Status.Credentials {
+		if !hasCredentials(instance, status.Name) {
+			removed = append(removed, status)
+		}
+	}
+	if err := r.deleteAdditionalCredentials(session, instance, removed); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// getAdditionalCredentials returns the contents of the credentials recorded in status, creating them if they do not exist yet.
+// Credentials whose parameter values changed are replaced with new ones, and the previous ones are deleted.
+func (r *BindingReconciler) getAdditionalCredentials(ctx context.Context, session *session.Session, instance *ibmcloudv1.Binding, credentials ibmcloudv1.BindingCredentials, status *ibmcloudv1.BindingCredentialsStatus) (map[string]interface{}, error) {
+	var previousKeyInstanceID string
+	if status.KeyInstanceID != "" {
+		paramsChanged, err := r.credentialsParametersChanged(ctx, instance, credentials, status)
+		if err != nil {
+			r.Log.Error(err, "Failed to resolve parameters", "credentials", credentials.Name)
+		}
+		if paramsChanged {
+			r.Log.Info("Parameters changed", "Replacing", credentials.Name)
+			previousKeyInstanceID = status.KeyInstanceID
+		} else {
+			_, _, keyContents, err := r.GetResourceServiceKey(session, status.KeyInstanceID)
+			if err == nil {
+				return keyContents, nil
+			}
+			if !strings.Contains(err.Error(), notFound) {
+				return nil, err
+			}
+			r.Log.Info("Credentials do not exist", "Recreating", credentials.Name)
+		}
+	}
+
+	parameters, err := r.getParams(ctx, credentials.Parameters, instance.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	paramsHash, err := credentialsParametersHash(ctx, r.HashKey, parameters)
+	if err != nil {
+		return nil, err
+	}
+	keyInstanceID, keyContents, err := r.getResourceServiceCredentials(session, instance, additionalKeyName(instance, credentials.Name), credentials.Role, parameters)
+	if err != nil {
+		return nil, err
+	}
+	status.KeyInstanceID = keyInstanceID
+	status.ParametersHash = paramsHash
+	if previousKeyInstanceID != "" {
+		if err := r.DeleteResourceServiceKey(session, previousKeyInstanceID); err != nil {
+			r.Log.Error(err, "Failed to delete replaced credentials", "credentials", credentials.Name, "KeyInstanceID", previousKeyInstanceID)
+		}
+	}
+	return keyContents, nil
+}
+
+// syncCredentialsSecret creates or updates the separate secret of additional credentials.
+// Returns the hash of the secret's contents, and true if the secret was created or updated.
+func (r *BindingReconciler) syncCredentialsSecret(ctx context.Context, instance *ibmcloudv1.Binding, secretName, keyInstanceID string, keyContents map[string]interface{}) (string, bool, error) {
+	data, err := processKey(keyContents)
+	if err != nil {
+		return "", false, err
+	}
+	hashKey, err := r.HashKey.Get(ctx)
+	if err != nil {
+		return "", false, err
+	}
+	hash := secretHash(hashKey, data)
+	annotations := map[string]string{
+		"service-instance-id": instance.Status.InstanceID,
+		"service-key-id":      keyInstanceID,
+		"bindingFromName":     instance.Spec.ServiceName,
+		secretHashAnnotation:  hash,
+	}
+
+	existing := &corev1.Secret{}
+	err = r.Get(ctx, types.NamespacedName{Name: secretName, Namespace: instance.Namespace}, existing)
+	switch {
+	case errors.IsNotFound(err):
+		r.Log.Info("Creating ", "secret", secretName)
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        secretName,
+				Namespace:   instance.Namespace,
+				Annotations: annotations,
+			},
+			Data: data,
+		}
+		if err := r.SetControllerReference(instance, secret, r.Scheme); err != nil {
+			return "", false, err
+		}
+		return hash, true, r.Create(ctx, secret)
+	case err != nil:
+		return "", false, err
+	case !metav1.IsControlledBy(existing, instance):
+		return "", false, errors.NewAlreadyExists(corev1.Resource("secrets"), secretName)
+	case !reflect.DeepEqual(existing.Data, data) || existing.Annotations[secretHashAnnotation] != annotations[secretHashAnnotation] ||
+		existing.Annotations["service-key-id"] != keyInstanceID:
+		r.Log.Info("Updating ", "secret", secretName)
+		if existing.Annotations == nil {
+			existing.Annotations = make(map[string]string)
+		}
+		for key, value := range annotations {
+			existing.Annotations[key] = value
+		}
+		existing.Data = data
+		return hash, true, r.Update(ctx, existing)
+	}
+	return hash, false, nil
+}
+
+// deleteAdditionalCredentials deletes the given credentials and their secrets, and removes them from the Binding's status
+func (r *BindingReconciler) deleteAdditionalCredentials(session *session.Session, instance *ibmcloudv1.Binding, credentials []ibmcloudv1.BindingCredentialsStatus) error {
+	for _, status := range credentials {
+		if instance.Spec.DeletionPolicy != ibmcloudv1.DeletionPolicyRetain && status.KeyInstanceID != "" {
+			r.Log.Info("Deleting", "credentials", status.Name, "KeyInstanceID", status.KeyInstanceID)
+			if err := r.DeleteResourceServiceKey(session, status.KeyInstanceID); err != nil {
+				return err
+			}
+		}
+		if status.SecretName != "" {
+			if err := r.deleteCredentialsSecret(context.Background(), instance, status.SecretName); err != nil {
+				return err
+			}
+		}
+		removeCredentialsStatus(instance, status.Name)
+	}
+	return nil
+}
+
+// deleteCredentialsSecret deletes a separate secret of additional credentials, if it still exists and belongs to the Binding
+func (r *BindingReconciler) deleteCredentialsSecret(ctx context.Context, instance *ibmcloudv1.Binding, secretName string) error {
+	existing := &corev1.Secret{}
+	err := r.Get(ctx, types.NamespacedName{Name: secretName, Namespace: instance.Namespace}, existing)
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !metav1.IsControlledBy(existing, instance) {
+		return nil
+	}
+	r.Log.Info("Deleting ", "secret", secretName)
+	if err := r.Delete(ctx, existing); err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// withAdditionalCredentials returns the credentials with the entries of additional credentials added
+func withAdditionalCredentials(keyContents, entries map[string]interface{}) map[string]interface{} {
+	if len(entries) == 0 {
+		return keyContents
+	}
+	result := make(map[string]interface{}, len(keyContents)+len(entries))
+	for key, value := range keyContents {
+		result[key] = value
+	}
+	for key, value := range entries {
+		result[key] = value
+	}
+	return result
+}
+
+func hasCredentials(instance *ibmcloudv1.Binding, name string) bool {
+	for _, credentials := range instance.Spec.Credentials {
+		if credentials.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func getCredentialsStatus(instance *ibmcloudv1.Binding, name string) ibmcloudv1.BindingCredentialsStatus {
+	for _, status := range instance.Status.Credentials {
+		if status.Name == name {
+			return status
+		}
+	}
+	return ibmcloudv1.BindingCredentialsStatus{Name: name}
+}
+
+func setCredentialsStatus(instance *ibmcloudv1.Binding, status ibmcloudv1.BindingCredentialsStatus) {
+	for i := range instance.Status.Credentials {
+		if instance.Status.Credentials[i].Name == status.Name {
+			instance.Status.Credentials[i] = status
+			return
+		}
+	}
+	instance.Status.Credentials = append(instance.Status.Credentials, status)
+}
+
+func removeCredentialsStatus(instance *ibmcloudv1.Binding, name string) {
+	var result []ibmcloudv1.BindingCredentialsStatus
+	for _, status := range instance.Status.Credentials {
+		if status.Name != name {
+			result = append(result, status)
+		}
+	}
+	instance.Status.Credentials = result
+}
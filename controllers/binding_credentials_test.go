@@ -0,0 +1,197 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/IBM-Cloud/bluemix-go/crn"
+	"github.com/IBM-Cloud/bluemix-go/session"
+	ibmcloudv1 "github.com/ibm/cloud-operators/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+func TestBindingSyncAdditionalCredentials(t *testing.T) {
+	t.Parallel()
+	const namespace = "mynamespace"
+	scheme := schemas(t)
+	require.NoError(t, appsv1.AddToScheme(scheme))
+	binding := &ibmcloudv1.Binding{
+		ObjectMeta: metav1.ObjectMeta{Name: "mybinding", Namespace: namespace, UID: "binding-uid"},
+		Spec: ibmcloudv1.BindingSpec{
+			ServiceName:      "myservice",
+			RestartWorkloads: true,
+			Credentials: []ibmcloudv1.BindingCredentials{
+				{Name: "reader", Role: "Reader"},
+				{Name: "analytics", Role: "Reader", SecretName: "analytics-secret"},
+			},
+		},
+		Status: ibmcloudv1.BindingStatus{
+			InstanceID: "myinstance",
+			Credentials: []ibmcloudv1.BindingCredentialsStatus{
+				{Name: "reader", KeyInstanceID: "readerkey"},
+				{Name: "old", KeyInstanceID: "oldkey", SecretName: "old-secret"},
+			},
+		},
+	}
+	oldSecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "old-secret", Namespace: namespace}}
+	require.NoError(t, controllerutil.SetControllerReference(binding, oldSecret, scheme))
+
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "analytics", Namespace: namespace},
+		Spec: appsv1.DeploymentSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+			Volumes: []corev1.Volume{{VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "analytics-secret"}}}},
+		}}},
+	}
+
+	var createdKeys, roles, deletedKeys []string
+	client := fake.NewFakeClientWithScheme(scheme, oldSecret, deployment)
+	r := &BindingReconciler{
+		Client:                 client,
+		APIReader:              client,
+		Log:                    testLogger(t),
+		Scheme:                 scheme,
+		Recorder:               record.NewFakeRecorder(10),
+		SetControllerReference: controllerutil.SetControllerReference,
+
+		GetResourceServiceKey: func(session *session.Session, keyID string) (string, string, map[string]interface{}, error) {
+			return keyID, "", map[string]interface{}{"apikey": keyID}, nil
+		},
+		GetServiceInstanceCRN: func(session *session.Session, instanceID string) (crn.CRN, string, error) {
+			return crn.CRN{}, "", nil
+		},
+		GetServiceName: func(session *session.Session, serviceID string) (string, error) {
+			return "", nil
+		},
+		GetServiceRoleCRN: func(session *session.Session, serviceName, roleName string) (crn.CRN, error) {
+			roles = append(roles, roleName)
+			return crn.CRN{}, nil
+		},
+		CreateResourceServiceKey: func(session *session.Session, name string, crn crn.CRN, parameters map[string]interface{}) (string, map[string]interface{}, error) {
+			createdKeys = append(createdKeys, name)
+			return "analyticskey", map[string]interface{}{"apikey": "analyticskey"}, nil
+		},
+		DeleteResourceServiceKey: func(session *session.Session, keyID string) error {
+			deletedKeys = append(deletedKeys, keyID)
+			return nil
+		},
+	}
+
+	entries, err := r.syncAdditionalCredentials(context.Background(), nil, binding, "")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"reader_apikey": "readerkey"}, entries)
+	assert.Equal(t, []string{"mybinding-analytics"}, createdKeys)
+	assert.Equal(t, []string{"Reader"}, roles)
+	assert.Equal(t, []string{"oldkey"}, deletedKeys)
+	assert.Equal(t, []ibmcloudv1.BindingCredentialsStatus{
+		{Name: "reader", KeyInstanceID: "readerkey", ParametersHash: noParametersHash},
+		{Name: "analytics", KeyInstanceID: "analyticskey", ParametersHash: noParametersHash, SecretName: "analytics-secret"},
+	}, binding.Status.Credentials)
+
+	var secret corev1.Secret
+	require.NoError(t, r.Get(context.Background(), types.NamespacedName{Name: "analytics-secret", Namespace: namespace}, &secret))
+	assert.Equal(t, map[string][]byte{"apikey": []byte("analyticskey")}, secret.Data)
+	assert.Equal(t, "analyticskey", secret.Annotations["service-key-id"])
+	assert.True(t, metav1.IsControlledBy(&secret, binding))
+	assert.Error(t, r.Get(context.Background(), types.NamespacedName{Name: "old-secret", Namespace: namespace}, &secret), "Removed credentials' secret should be deleted")
+
+	require.NoError(t, r.Get(context.Background(), types.NamespacedName{Name: "analytics", Namespace: namespace}, deployment))
+	assert.Equal(t, secretHash(nil, secret.Data), deployment.Spec.Template.Annotations[restartAnnotation(binding, "analytics-secret")],
+		"Workloads using the credentials' secret should be restarted")
+	events := r.Recorder.(*record.FakeRecorder).Events
+	require.Len(t, events, 1)
+	<-events
+	_, err = r.syncAdditionalCredentials(context.Background(), nil, binding, "")
+	require.NoError(t, err)
+	assert.Empty(t, events, "Workloads should not be restarted again while the secret is unchanged")
+
+	_, err = r.syncAdditionalCredentials(context.Background(), nil, binding, "CF")
+	assert.EqualError(t, err, "additional credentials are not supported for CF services")
+}
+
+func TestBindingAdditionalCredentialsParametersChanged(t *testing.T) {
+	t.Parallel()
+	const namespace = "mynamespace"
+	scheme := schemas(t)
+	params := []ibmcloudv1.Param{{
+		Name: "level",
+		ValueFrom: &ibmcloudv1.ParamSource{
+			SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "writer-params"}, Key: "level"},
+		},
+	}}
+	previousHash, err := parametersHash(context.Background(), nil, map[string]interface{}{"level": "low"})
+	require.NoError(t, err)
+	expectHash, err := parametersHash(context.Background(), nil, map[string]interface{}{"level": "high"})
+	require.NoError(t, err)
+
+	binding := &ibmcloudv1.Binding{
+		ObjectMeta: metav1.ObjectMeta{Name: "mybinding", Namespace: namespace},
+		Spec: ibmcloudv1.BindingSpec{
+			ServiceName: "myservice",
+			Credentials: []ibmcloudv1.BindingCredentials{{Name: "writer", Role: "Writer", Parameters: params}},
+		},
+		Status: ibmcloudv1.BindingStatus{
+			InstanceID:  "myinstance",
+			Credentials: []ibmcloudv1.BindingCredentialsStatus{{Name: "writer", KeyInstanceID: "writerkey", ParametersHash: previousHash}},
+		},
+	}
+	var createdParams []map[string]interface{}
+	var deletedKeys []string
+	r := &BindingReconciler{
+		Client: fake.NewFakeClientWithScheme(scheme, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "writer-params", Namespace: namespace},
+			Data:       map[string][]byte{"level": []byte("high")},
+		}),
+		Log:    testLogger(t),
+		Scheme: scheme,
+
+		GetResourceServiceKey: func(session *session.Session, keyID string) (string, string, map[string]interface{}, error) {
+			panic("Credentials with changed parameters should be replaced, not fetched")
+		},
+		GetServiceInstanceCRN: func(session *session.Session, instanceID string) (crn.CRN, string, error) {
+			return crn.CRN{}, "", nil
+		},
+		GetServiceName: func(session *session.Session, serviceID string) (string, error) {
+			return "", nil
+		},
+		GetServiceRoleCRN: func(session *session.Session, serviceName, roleName string) (crn.CRN, error) {
+			return crn.CRN{}, nil
+		},
+		CreateResourceServiceKey: func(session *session.Session, name string, crn crn.CRN, parameters map[string]interface{}) (string, map[string]interface{}, error) {
+			createdParams = append(createdParams, parameters)
+			return "newwriterkey", map[string]interface{}{"apikey": "newwriterkey"}, nil
+		},
+		DeleteResourceServiceKey: func(session *session.Session, keyID string) error {
+			deletedKeys = append(deletedKeys, keyID)
+			return nil
+		},
+	}
+
+	entries, err := r.syncAdditionalCredentials(context.Background(), nil, binding, "")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"writer_apikey": "newwriterkey"}, entries)
+	require.Len(t, createdParams, 1)
+	assert.Equal(t, "high", createdParams[0]["level"])
+	assert.Equal(t, []string{"writerkey"}, deletedKeys)
+	assert.Equal(t, []ibmcloudv1.BindingCredentialsStatus{
+		{Name: "writer", KeyInstanceID: "newwriterkey", ParametersHash: expectHash},
+	}, binding.Status.Credentials)
+}
+
+func TestWithAdditionalCredentials(t *testing.T) {
+	t.Parallel()
+	keyContents := map[string]interface{}{"apikey": "mykey"}
+	assert.Equal(t, keyContents, withAdditionalCredentials(keyContents, nil))
+	assert.Equal(t,
+		map[string]interface{}{"apikey": "mykey", "reader_apikey": "readerkey"},
+		withAdditionalCredentials(keyContents, map[string]interface{}{"reader_apikey": "readerkey"}),
+	)
+	assert.Equal(t, map[string]interface{}{"apikey": "mykey"}, keyContents, "Credentials should not be modified")
+}
@@ -0,0 +1,50 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	ibmcloudv1 "github.com/ibm/cloud-operators/api/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// checkBindingAllowed returns an error if the Binding is in another namespace than its Service,
+// and the Service does not allow Bindings from that namespace by name or label.
+// A Binding to an instance by CRN has no Service to allow it, so its namespace must be allowed by the operator instead.
+func (r *BindingReconciler) checkBindingAllowed(ctx context.Context, instance *ibmcloudv1.Binding, serviceInstance *ibmcloudv1.Service) error {
+	if instance.Spec.InstanceCRN != "" {
+		for _, namespace := range r.InstanceCRNNamespaces {
+			if namespace == instance.Namespace {
+				return nil
+			}
+		}
+		return fmt.Errorf("Bindings in namespace %s may not set instanceCRN: add the namespace to the operator's INSTANCE_CRN_NAMESPACES", instance.Namespace)
+	}
+	if serviceInstance.Namespace == instance.Namespace {
+		return nil
+	}
+	for _, namespace := range serviceInstance.Spec.AllowedBindingNamespaces {
+		if namespace == instance.Namespace {
+			return nil
+		}
+	}
+	if selector := serviceInstance.Spec.AllowedBindingNamespaceSelector; selector != nil && (len(selector.MatchLabels) > 0 || len(selector.MatchExpressions) > 0) {
+		// an empty selector would select every namespace, so one created before the webhook rejected it allows none
+		selector, err := metav1.LabelSelectorAsSelector(selector)
+		if err != nil {
+			return err
+		}
+		namespace := &corev1.Namespace{}
+		if err := r.Get(ctx, types.NamespacedName{Name: instance.Namespace}, namespace); err != nil {
+			return err
+		}
+		if selector.Matches(labels.Set(namespace.Labels)) {
+			return nil
+		}
+	}
+	return fmt.Errorf("Service %s/%s does not allow Bindings from namespace %s: add the namespace to the Service's allowedBindingNamespaces or allowedBindingNamespaceSelector",
+		serviceInstance.Namespace, serviceInstance.Name, instance.Namespace)
+}
@@ -0,0 +1,100 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	ibmcloudv1 "github.com/ibm/cloud-operators/api/v1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestBindingCheckAllowed(t *testing.T) {
+	t.Parallel()
+	const notAllowedErr = "Service servicenamespace/myservice does not allow Bindings from namespace app1: add the namespace to the Service's allowedBindingNamespaces or allowedBindingNamespaceSelector"
+
+	for _, tc := range []struct {
+		description string
+		binding     ibmcloudv1.Binding
+		serviceSpec ibmcloudv1.ServiceSpec
+		expectErr   string
+	}{
+		{
+			description: "same namespace",
+			binding:     ibmcloudv1.Binding{ObjectMeta: metav1.ObjectMeta{Name: "mybinding", Namespace: "servicenamespace"}},
+		},
+		{
+			description: "other namespace not allowed",
+			binding:     ibmcloudv1.Binding{ObjectMeta: metav1.ObjectMeta{Name: "mybinding", Namespace: "app1"}},
+			serviceSpec: ibmcloudv1.ServiceSpec{AllowedBindingNamespaces: []string{"app2"}},
+			expectErr:   notAllowedErr,
+		},
+		{
+			description: "other namespace allowed by name",
+			binding:     ibmcloudv1.Binding{ObjectMeta: metav1.ObjectMeta{Name: "mybinding", Namespace: "app1"}},
+			serviceSpec: ibmcloudv1.ServiceSpec{AllowedBindingNamespaces: []string{"app2", "app1"}},
+		},
+		{
+			description: "other namespace allowed by label",
+			binding:     ibmcloudv1.Binding{ObjectMeta: metav1.ObjectMeta{Name: "mybinding", Namespace: "app1"}},
+			serviceSpec: ibmcloudv1.ServiceSpec{
+				AllowedBindingNamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}},
+			},
+		},
+		{
+			description: "instance CRN allowed by the operator",
+			binding: ibmcloudv1.Binding{
+				ObjectMeta: metav1.ObjectMeta{Name: "mybinding", Namespace: "app1"},
+				Spec:       ibmcloudv1.BindingSpec{InstanceCRN: "crn:v1:bluemix:public:cloudantnosqldb:us-south:a/account:instance::"},
+			},
+		},
+		{
+			description: "instance CRN not allowed by the operator",
+			binding: ibmcloudv1.Binding{
+				ObjectMeta: metav1.ObjectMeta{Name: "mybinding", Namespace: "app2"},
+				Spec:       ibmcloudv1.BindingSpec{InstanceCRN: "crn:v1:bluemix:public:cloudantnosqldb:us-south:a/account:instance::"},
+			},
+			expectErr: "Bindings in namespace app2 may not set instanceCRN: add the namespace to the operator's INSTANCE_CRN_NAMESPACES",
+		},
+		{
+			description: "empty selector allows no namespace",
+			binding:     ibmcloudv1.Binding{ObjectMeta: metav1.ObjectMeta{Name: "mybinding", Namespace: "app1"}},
+			serviceSpec: ibmcloudv1.ServiceSpec{AllowedBindingNamespaceSelector: &metav1.LabelSelector{}},
+			expectErr:   notAllowedErr,
+		},
+		{
+			description: "other namespace label not selected",
+			binding:     ibmcloudv1.Binding{ObjectMeta: metav1.ObjectMeta{Name: "mybinding", Namespace: "app1"}},
+			serviceSpec: ibmcloudv1.ServiceSpec{
+				AllowedBindingNamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "b"}},
+			},
+			expectErr: notAllowedErr,
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			scheme := schemas(t)
+			r := &BindingReconciler{
+				Client: fake.NewFakeClientWithScheme(scheme,
+					&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "app1", Labels: map[string]string{"team": "a"}}},
+				),
+				Log:    testLogger(t),
+				Scheme: scheme,
+
+				InstanceCRNNamespaces: []string{"app1"},
+			}
+			service := &ibmcloudv1.Service{
+				ObjectMeta: metav1.ObjectMeta{Name: "myservice", Namespace: "servicenamespace"},
+				Spec:       tc.serviceSpec,
+			}
+
+			err := r.checkBindingAllowed(context.Background(), &tc.binding, service)
+			if tc.expectErr != "" {
+				assert.EqualError(t, err, tc.expectErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
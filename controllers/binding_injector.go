@@ -0,0 +1,221 @@
+/*
+ * Copyright 2021 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/go-logr/logr"
+	ibmcloudv1 "github.com/ibm/cloud-operators/api/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+const (
+	bindingInjectorPath = "/mutate-v1-pod"
+
+	// bindingsAnnotation is the Pod annotation listing the Bindings whose secrets are injected into its containers
+	bindingsAnnotation = "ibmcloud.ibm.com/bindings"
+	// bindingsMountPathAnnotation is the Pod annotation which mounts the Bindings' secrets as files under this directory,
+	// instead of injecting them as environment variables
+	bindingsMountPathAnnotation = "ibmcloud.ibm.com/bindingsMountPath"
+	// bindingsRequiredAnnotation is the Pod annotation which rejects the Pod if any of its Bindings has no secret yet,
+	// instead of creating it without that Binding's secret
+	bindingsRequiredAnnotation = "ibmcloud.ibm.com/bindingsRequired"
+)
+
+// The webhook only receives Pods labeled ibmcloud.ibm.com/inject-bindings=true, outside the operator's namespace.
+// controller-gen cannot generate selectors, so they are added by config/webhook/pod_webhook_patch.yaml.
+// +kubebuilder:webhook:path=/mutate-v1-pod,mutating=true,failurePolicy=ignore,sideEffects=None,groups="",resources=pods,verbs=create,versions=v1,name=mpod.ibmcloud.ibm.com,admissionReviewVersions=v1beta1
+
+// BindingInjector adds the secrets of the Bindings listed in a new Pod's ibmcloud.ibm.com/bindings annotation to its containers,
+// as environment variables or as files in a volume per Binding. Bindings without a secret are left out,
+// unless the Pod's ibmcloud.ibm.com/bindingsRequired annotation is "true".
+type BindingInjector struct {
+	Client client.Client
+	Log    logr.Logger
+
+	decoder *admission.Decoder
+}
+
+var _ admission.Handler = &BindingInjector{}
+
+// Handle injects the Bindings' secrets on Pod creation
+func (i *BindingInjector) Handle(ctx context.Context, req admission.Request) admission.Response {
+	pod := &corev1.Pod{}
+	if err := i.decoder.Decode(req, pod); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	bindingNames := parseBindingsAnnotation(pod.Annotations[bindingsAnnotation])
+	if len(bindingNames) == 0 {
+		return admission.Allowed("no bindings to inject")
+	}
+	namespace := pod.Namespace
+	if namespace == "" {
+		namespace = req.Namespace
+	}
+	logt := i.Log.WithValues("namespace", namespace, "bindings", bindingNames)
+
+	required := pod.Annotations[bindingsRequiredAnnotation] == "true"
+	readyBindings := make([]string, 0, len(bindingNames))
+	secretNames := make([]string, 0, len(bindingNames))
+	for _, bindingName := range bindingNames {
+		secretName, err := i.bindingSecretName(ctx, types.NamespacedName{Name: bindingName, Namespace: namespace})
+		if err != nil {
+			logt.Info("Unable to resolve binding secret", "binding", bindingName, "error", err.Error())
+			if required {
+				return admission.Denied(err.Error())
+			}
+			continue
+		}
+		readyBindings = append(readyBindings, bindingName)
+		secretNames = append(secretNames, secretName)
+	}
+	if len(secretNames) == 0 {
+		return admission.Allowed("no binding secrets ready to inject")
+	}
+
+	if mountPath := pod.Annotations[bindingsMountPathAnnotation]; mountPath != "" {
+		injectBindingVolumes(&pod.Spec, readyBindings, secretNames, mountPath)
+	} else {
+		injectBindingEnv(&pod.Spec, secretNames)
+	}
+
+	marshaled, err := json.Marshal(pod)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	return admission.PatchResponseFromRaw(req.Object.Raw, marshaled)
+}
+
+// InjectDecoder implements admission.DecoderInjector
+func (i *BindingInjector) InjectDecoder(decoder *admission.Decoder) error {
+	i.decoder = decoder
+	return nil
+}
+
+// bindingSecretName returns the name of the secret created for the Binding
+func (i *BindingInjector) bindingSecretName(ctx context.Context, name types.NamespacedName) (string, error) {
+	binding := &ibmcloudv1.Binding{}
+	err := i.Client.Get(ctx, name, binding)
+	if errors.IsNotFound(err) {
+		return "", fmt.Errorf("binding %q not found in namespace %q", name.Name, name.Namespace)
+	}
+	if err != nil {
+		return "", err
+	}
+	if binding.Status.SecretName == "" {
+		return "", fmt.Errorf("binding %q has not created its secret yet", name.Name)
+	}
+	return binding.Status.SecretName, nil
+}
+
+// parseBindingsAnnotation splits a comma-separated list of Binding names, skipping empty entries and duplicates
+func parseBindingsAnnotation(value string) []string {
+	var names []string
+	seen := make(map[string]bool)
+	for _, name := range strings.Split(value, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+		names = append(names, name)
+	}
+	return names
+}
+
+// injectBindingEnv adds each secret to every container's envFrom, unless the container already references it
+func injectBindingEnv(spec *corev1.PodSpec, secretNames []string) {
+	inject := func(container *corev1.Container) {
+		for _, secretName := range secretNames {
+			if containerUsesSecretEnv(*container, secretName) {
+				continue
+			}
+			container.EnvFrom = append(container.EnvFrom, corev1.EnvFromSource{
+				SecretRef: &corev1.SecretEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: secretName}},
+			})
+		}
+	}
+	for i := range spec.InitContainers {
+		inject(&spec.InitContainers[i])
+	}
+	for i := range spec.Containers {
+		inject(&spec.Containers[i])
+	}
+}
+
+func containerUsesSecretEnv(container corev1.Container, secretName string) bool {
+	for _, envFrom := range container.EnvFrom {
+		if envFrom.SecretRef != nil && envFrom.SecretRef.Name == secretName {
+			return true
+		}
+	}
+	return false
+}
+
+// injectBindingVolumes adds a volume for each Binding's secret and mounts it read-only in every container at mountPath/<binding name>
+func injectBindingVolumes(spec *corev1.PodSpec, bindingNames, secretNames []string, mountPath string) {
+	for index, bindingName := range bindingNames {
+		volumeName := bindingVolumeName(bindingName)
+		if hasVolume(*spec, volumeName) {
+			continue
+		}
+		spec.Volumes = append(spec.Volumes, corev1.Volume{
+			Name:         volumeName,
+			VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: secretNames[index]}},
+		})
+		mount := corev1.VolumeMount{
+			Name:      volumeName,
+			MountPath: path.Join(mountPath, bindingName),
+			ReadOnly:  true,
+		}
+		for i := range spec.InitContainers {
+			spec.InitContainers[i].VolumeMounts = append(spec.InitContainers[i].VolumeMounts, mount)
+		}
+		for i := range spec.Containers {
+			spec.Containers[i].VolumeMounts = append(spec.Containers[i].VolumeMounts, mount)
+		}
+	}
+}
+
+// bindingVolumeName returns the name of the injected volume for a Binding, which must fit in a 63 character DNS label
+func bindingVolumeName(bindingName string) string {
+	const maxLength = 63
+	name := "binding-" + bindingName
+	if len(name) > maxLength {
+		name = strings.TrimRight(name[:maxLength], "-.")
+	}
+	return strings.ReplaceAll(name, ".", "-")
+}
+
+func hasVolume(spec corev1.PodSpec, volumeName string) bool {
+	for _, volume := range spec.Volumes {
+		if volume.Name == volumeName {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,172 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	ibmcloudv1 "github.com/ibm/cloud-operators/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gomodules.xyz/jsonpatch/v2"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+func TestParseBindingsAnnotation(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, []string{"cos-binding", "db-binding"}, parseBindingsAnnotation(" cos-binding, db-binding,,cos-binding "))
+	assert.Empty(t, parseBindingsAnnotation(""))
+}
+
+func TestInjectBindingEnv(t *testing.T) {
+	t.Parallel()
+	secretRef := func(name string) corev1.EnvFromSource {
+		return corev1.EnvFromSource{SecretRef: &corev1.SecretEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: name}}}
+	}
+	spec := corev1.PodSpec{
+		InitContainers: []corev1.Container{{Name: "init"}},
+		Containers: []corev1.Container{
+			{Name: "app"},
+			{Name: "sidecar", EnvFrom: []corev1.EnvFromSource{secretRef("db-secret")}},
+		},
+	}
+
+	injectBindingEnv(&spec, []string{"cos-secret", "db-secret"})
+	assert.Equal(t, []corev1.EnvFromSource{secretRef("cos-secret"), secretRef("db-secret")}, spec.InitContainers[0].EnvFrom)
+	assert.Equal(t, []corev1.EnvFromSource{secretRef("cos-secret"), secretRef("db-secret")}, spec.Containers[0].EnvFrom)
+	assert.Equal(t, []corev1.EnvFromSource{secretRef("db-secret"), secretRef("cos-secret")}, spec.Containers[1].EnvFrom)
+}
+
+func TestInjectBindingVolumes(t *testing.T) {
+	t.Parallel()
+	spec := corev1.PodSpec{Containers: []corev1.Container{{Name: "app"}, {Name: "sidecar"}}}
+
+	injectBindingVolumes(&spec, []string{"cos-binding"}, []string{"cos-secret"}, "/bindings")
+	injectBindingVolumes(&spec, []string{"cos-binding"}, []string{"cos-secret"}, "/bindings")
+	assert.Equal(t, []corev1.Volume{{
+		Name:         "binding-cos-binding",
+		VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "cos-secret"}},
+	}}, spec.Volumes)
+	expectMounts := []corev1.VolumeMount{{Name: "binding-cos-binding", MountPath: "/bindings/cos-binding", ReadOnly: true}}
+	assert.Equal(t, expectMounts, spec.Containers[0].VolumeMounts)
+	assert.Equal(t, expectMounts, spec.Containers[1].VolumeMounts)
+}
+
+func TestBindingVolumeName(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "binding-my-binding", bindingVolumeName("my.binding"))
+	long := bindingVolumeName("a-binding-name-which-is-longer-than-a-volume-name-may-be-at-all")
+	assert.Len(t, long, 63)
+}
+
+func TestBindingInjector(t *testing.T) {
+	t.Parallel()
+	const namespace = "mynamespace"
+
+	for _, tc := range []struct {
+		description   string
+		annotations   map[string]string
+		expectAllowed bool
+		expectReason  string
+		expectPatches []jsonpatch.JsonPatchOperation
+	}{
+		{
+			description:   "no annotation",
+			expectAllowed: true,
+		},
+		{
+			description:   "inject env",
+			annotations:   map[string]string{bindingsAnnotation: "mybinding"},
+			expectAllowed: true,
+			expectPatches: []jsonpatch.JsonPatchOperation{
+				{Operation: "add", Path: "/spec/containers/0/envFrom", Value: []interface{}{
+					map[string]interface{}{"secretRef": map[string]interface{}{"name": "mysecret"}},
+				}},
+			},
+		},
+		{
+			description:   "mount files",
+			annotations:   map[string]string{bindingsAnnotation: "mybinding", bindingsMountPathAnnotation: "/bindings"},
+			expectAllowed: true,
+			expectPatches: []jsonpatch.JsonPatchOperation{
+				{Operation: "add", Path: "/spec/volumes", Value: []interface{}{
+					map[string]interface{}{"name": "binding-mybinding", "secret": map[string]interface{}{"secretName": "mysecret"}},
+				}},
+				{Operation: "add", Path: "/spec/containers/0/volumeMounts", Value: []interface{}{
+					map[string]interface{}{"name": "binding-mybinding", "mountPath": "/bindings/mybinding", "readOnly": true},
+				}},
+			},
+		},
+		{
+			description:   "binding not found",
+			annotations:   map[string]string{bindingsAnnotation: "mybinding,otherbinding"},
+			expectAllowed: true,
+			expectPatches: []jsonpatch.JsonPatchOperation{
+				{Operation: "add", Path: "/spec/containers/0/envFrom", Value: []interface{}{
+					map[string]interface{}{"secretRef": map[string]interface{}{"name": "mysecret"}},
+				}},
+			},
+		},
+		{
+			description:   "binding secret not created",
+			annotations:   map[string]string{bindingsAnnotation: "pendingbinding"},
+			expectAllowed: true,
+		},
+		{
+			description:  "required binding not found",
+			annotations:  map[string]string{bindingsAnnotation: "mybinding,otherbinding", bindingsRequiredAnnotation: "true"},
+			expectReason: `binding "otherbinding" not found in namespace "mynamespace"`,
+		},
+		{
+			description:  "required binding secret not created",
+			annotations:  map[string]string{bindingsAnnotation: "pendingbinding", bindingsRequiredAnnotation: "true"},
+			expectReason: `binding "pendingbinding" has not created its secret yet`,
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			scheme := schemas(t)
+			decoder, err := admission.NewDecoder(scheme)
+			require.NoError(t, err)
+			i := &BindingInjector{
+				Client: fake.NewFakeClientWithScheme(scheme,
+					&ibmcloudv1.Binding{
+						ObjectMeta: metav1.ObjectMeta{Name: "mybinding", Namespace: namespace},
+						Status:     ibmcloudv1.BindingStatus{SecretName: "mysecret"},
+					},
+					&ibmcloudv1.Binding{
+						ObjectMeta: metav1.ObjectMeta{Name: "pendingbinding", Namespace: namespace},
+					},
+				),
+				Log: testLogger(t),
+			}
+			require.NoError(t, i.InjectDecoder(decoder))
+
+			pod := &corev1.Pod{
+				TypeMeta:   metav1.TypeMeta{Kind: "Pod", APIVersion: "v1"},
+				ObjectMeta: metav1.ObjectMeta{GenerateName: "myapp-", Annotations: tc.annotations},
+				Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "app", Image: "myapp"}}},
+			}
+			raw, err := json.Marshal(pod)
+			require.NoError(t, err)
+
+			resp := i.Handle(context.Background(), admission.Request{
+				AdmissionRequest: admissionv1beta1.AdmissionRequest{
+					Namespace: namespace,
+					Operation: admissionv1beta1.Create,
+					Object:    runtime.RawExtension{Raw: raw},
+				},
+			})
+			assert.Equal(t, tc.expectAllowed, resp.Allowed)
+			if !tc.expectAllowed {
+				assert.Equal(t, tc.expectReason, string(resp.Result.Reason))
+				return
+			}
+			assert.ElementsMatch(t, tc.expectPatches, resp.Patches)
+		})
+	}
+}
@@ -0,0 +1,214 @@
+package controllers
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"strings"
+
+	ibmcloudv1 "github.com/ibm/cloud-operators/api/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// bindingNameAnnotation and bindingNamespaceAnnotation identify the Binding which owns a secret copy in another namespace
+	bindingNameAnnotation      = "ibmcloud.ibm.com/bindingName"
+	bindingNamespaceAnnotation = "ibmcloud.ibm.com/bindingNamespace"
+	// acceptSecretsFromAnnotation on a namespace lists the comma separated namespaces whose Bindings may copy their secrets into it
+	acceptSecretsFromAnnotation = "ibmcloud.ibm.com/acceptSecretsFrom"
+)
+
+// +kubebuilder:rbac:groups=core,resources=namespaces,verbs=get;list;watch
+
+// getTargetNamespaces returns the sorted namespaces, other than the Binding's own, which should hold a copy of the secret.
+// Target namespaces which have not opted in are skipped with a warning event.
+func (r *BindingReconciler) getTargetNamespaces(ctx context.Context, instance *ibmcloudv1.Binding) ([]string, error) {
+	namespaces := make(map[string]bool)
+	for _, name := range instance.Spec.TargetNamespaces {
+		if name == instance.Namespace {
+			continue
+		}
+		namespace := &corev1.Namespace{}
+		if err := r.Get(ctx, types.NamespacedName{Name: name}, namespace); err != nil {
+			return nil, err
+		}
+		if !acceptsSecretsFrom(namespace, instance.Namespace) {
+			// like a deselected namespace, so any copy is deleted and the other namespaces are still synced
+			r.Recorder.Eventf(instance, corev1.EventTypeWarning, "TargetNamespaceNotAccepted",
+				"Namespace %s does not accept secrets from namespace %s: add %s to the %s annotation of namespace %s",
+				name, instance.Namespace, instance.Namespace, acceptSecretsFromAnnotation, name)
+			continue
+		}
+		namespaces[name] = true
+	}
+	if instance.Spec.TargetNamespaceSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(instance.Spec.TargetNamespaceSelector)
+		if err != nil {
+			return nil, err
+		}
+		var namespaceList corev1.NamespaceList
+		if err := r.List(ctx, &namespaceList, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+			return nil, err
+		}
+		for i := range namespaceList.Items {
+			// selected namespaces which have not opted in are skipped
+			if acceptsSecretsFrom(&namespaceList.Items[i], instance.Namespace) {
+				namespaces[namespaceList.Items[i].Name] = true
+			}
+		}
+	}
+	delete(namespaces, instance.Namespace)
+
+	targets := make([]string, 0, len(namespaces))
+	for namespace := range namespaces {
+		targets = append(targets, namespace)
+	}
+	sort.Strings(targets)
+	return targets, nil
+}
+
+// acceptsSecretsFrom returns true if the namespace opted in to receive secret copies from Bindings in sourceNamespace
+func acceptsSecretsFrom(namespace *corev1.Namespace, sourceNamespace string) bool {
+	for _, accepted := range strings.Split(namespace.Annotations[acceptSecretsFromAnnotation], ",") {
+		if strings.TrimSpace(accepted) == sourceNamespace {
+			return true
+		}
+	}
+	return false
+}
+
+// replicateSecret copies the Binding's credentials secret into each target namespace and deletes the copies from namespaces no longer targeted
+func (r *BindingReconciler) replicateSecret(ctx context.Context, instance *ibmcloudv1.Binding, keyContents map[string]interface{}) error {
+	targets, err := r.getTargetNamespaces(ctx, instance)
+	if err != nil {
+		return err
+	}
+	if previousName := instance.Status.SecretName; previousName != "" && previousName != getSecretName(instance) {
+		// the secret was renamed, so none of the copies under the previous name are current
+		if err := r.deleteSecretCopies(ctx, instance, previousName, instance.Status.TargetNamespaces); err != nil {
+			return err
+		}
+		instance.Status.TargetNamespaces = nil
+	}
+	if len(targets) > 0 {
+		hashKey, err := r.HashKey.Get(ctx)
+		if err != nil {
+			return err
+		}
+		secret, err := newSecret(instance, keyContents, hashKey)
+		if err != nil {
+			return err
+		}
+		for _, namespace := range targets {
+			if err := r.syncSecretCopy(ctx, instance, secret, namespace); err != nil {
+				return err
+			}
+		}
+	}
+
+	targeted := make(map[string]bool, len(targets))
+	for _, namespace := range targets {
+		targeted[namespace] = true
+	}
+	var removed []string
+	for _, namespace := range instance.Status.TargetNamespaces {
+		if !targeted[namespace] {
+			removed = append(removed, namespace)
+		}
+	}
+	if err := r.deleteSecretCopies(ctx, instance, getSecretName(instance), removed); err != nil {
+		return err
+	}
+	if len(targets) == 0 {
+		targets = nil
+	}
+	instance.Status.TargetNamespaces = targets
+	return nil
+}
+
+// syncSecretCopy creates or updates the copy of secret in namespace
+func (r *BindingReconciler) syncSecretCopy(ctx context.Context, instance *ibmcloudv1.Binding, secret *corev1.Secret, namespace string) error {
+	annotations := map[string]string{
+		bindingNameAnnotation:      instance.Name,
+		bindingNamespaceAnnotation: instance.Namespace,
+	}
+	for key, value := range secret.Annotations {
+		annotations[key] = value
+	}
+
+	existing := &corev1.Secret{}
+	err := r.Get(ctx, types.NamespacedName{Name: secret.Name, Namespace: namespace}, existing)
+	switch {
+	case errors.IsNotFound(err):
+		r.Log.Info("Creating ", "secret copy", secret.Name, "namespace", namespace)
+		return r.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        secret.Name,
+				Namespace:   namespace,
+				Annotations: annotations,
+			},
+			Type: secret.Type,
+			Data: secret.Data,
+		})
+	case err != nil:
+		return err
+	case !isSecretCopy(instance, existing):
+		return errors.NewAlreadyExists(corev1.Resource("secrets"), namespace+"/"+secret.Name)
+	}
+
+	if existing.Type != secret.Type {
+		// a secret's type is immutable, so replace the copy
+		r.Log.Info("Replacing ", "secret copy", secret.Name, "namespace", namespace, "type", secret.Type)
+		if err := r.Delete(ctx, existing); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		return r.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        secret.Name,
+				Namespace:   namespace,
+				Annotations: annotations,
+			},
+			Type: secret.Type,
+			Data: secret.Data,
+		})
+	}
+	if reflect.DeepEqual(existing.Data, secret.Data) && reflect.DeepEqual(existing.Annotations, annotations) {
+		return nil
+	}
+	r.Log.Info("Updating ", "secret copy", secret.Name, "namespace", namespace)
+	existing.Annotations = annotations
+	existing.Data = secret.Data
+	return r.Update(ctx, existing)
+}
+
+// deleteSecretCopies deletes the Binding's secret copies named secretName from namespaces, ignoring any which no longer exist
+func (r *BindingReconciler) deleteSecretCopies(ctx context.Context, instance *ibmcloudv1.Binding, secretName string, namespaces []string) error {
+	for _, namespace := range namespaces {
+		existing := &corev1.Secret{}
+		err := r.Get(ctx, types.NamespacedName{Name: secretName, Namespace: namespace}, existing)
+		if errors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if !isSecretCopy(instance, existing) {
+			continue
+		}
+		r.Log.Info("Deleting ", "secret copy", existing.Name, "namespace", namespace)
+		if err := r.Delete(ctx, existing); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// isSecretCopy returns true if secret was copied from instance's secret
+func isSecretCopy(instance *ibmcloudv1.Binding, secret *corev1.Secret) bool {
+	return secret.Annotations[bindingNameAnnotation] == instance.Name &&
+		secret.Annotations[bindingNamespaceAnnotation] == instance.Namespace
+}
@@ -0,0 +1,247 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	ibmcloudv1 "github.com/ibm/cloud-operators/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestBindingReplicateSecret(t *testing.T) {
+	t.Parallel()
+	const (
+		namespace   = "mynamespace"
+		bindingName = "mybinding"
+	)
+	copyAnnotations := func(keyID string) map[string]string {
+		return map[string]string{
+			bindingNameAnnotation:      bindingName,
+			bindingNamespaceAnnotation: namespace,
+			"service-instance-id":      "myinstance",
+			"service-key-id":           keyID,
+			"bindingFromName":          "myservice",
+			secretHashAnnotation:       secretHash(nil, map[string][]byte{"apikey": []byte(keyID)}),
+		}
+	}
+	newNamespace := func(name string, labels map[string]string) *corev1.Namespace {
+		return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Labels:      labels,
+			Annotations: map[string]string{acceptSecretsFromAnnotation: "othernamespace, " + namespace},
+		}}
+	}
+
+	for _, tc := range []struct {
+		description      string
+		spec             ibmcloudv1.BindingSpec
+		statusNamespaces []string
+		statusSecret     string
+		objects          []runtime.Object
+		expectErr        string
+		expectNamespaces []string
+		expectCopies     []string
+		expectNoCopies   []string
+		expectEvents     []string
+	}{
+		{
+			description: "no target namespaces",
+		},
+		{
+			description: "target namespaces and selector",
+			spec: ibmcloudv1.BindingSpec{
+				TargetNamespaces:        []string{"app1", namespace},
+				TargetNamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}},
+			},
+			objects: []runtime.Object{
+				newNamespace("app1", nil),
+				newNamespace("app2", map[string]string{"team": "a"}),
+				newNamespace("app3", map[string]string{"team": "b"}),
+				&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "app4", Labels: map[string]string{"team": "a"}}},
+			},
+			expectNamespaces: []string{"app1", "app2"},
+			expectCopies:     []string{"app1", "app2"},
+			expectNoCopies:   []string{"app3", "app4"},
+		},
+		{
+			description: "target namespace no longer opted in",
+			spec:        ibmcloudv1.BindingSpec{TargetNamespaces: []string{"app1", "app2"}},
+			objects: []runtime.Object{
+				&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "app1", Annotations: map[string]string{acceptSecretsFromAnnotation: "othernamespace"}}},
+				newNamespace("app2", nil),
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: bindingName, Namespace: "app1", Annotations: copyAnnotations("mykey")},
+					Data:       map[string][]byte{"apikey": []byte("mykey")},
+				},
+			},
+			statusNamespaces: []string{"app1", "app2"},
+			expectNamespaces: []string{"app2"},
+			expectCopies:     []string{"app2"},
+			expectNoCopies:   []string{"app1"},
+			expectEvents: []string{
+				"Warning TargetNamespaceNotAccepted Namespace app1 does not accept secrets from namespace mynamespace: add mynamespace to the ibmcloud.ibm.com/acceptSecretsFrom annotation of namespace app1",
+			},
+		},
+		{
+			description: "replace copy with changed type",
+			spec:        ibmcloudv1.BindingSpec{TargetNamespaces: []string{"app1"}},
+			objects: []runtime.Object{
+				newNamespace("app1", nil),
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: bindingName, Namespace: "app1", Annotations: copyAnnotations("mykey")},
+					Type:       corev1.SecretTypeTLS,
+					Data:       map[string][]byte{"apikey": []byte("mykey")},
+				},
+			},
+			statusNamespaces: []string{"app1"},
+			expectNamespaces: []string{"app1"},
+			expectCopies:     []string{"app1"},
+		},
+		{
+			description: "delete copies of renamed secret",
+			spec:        ibmcloudv1.BindingSpec{TargetNamespaces: []string{"app1"}},
+			objects: []runtime.Object{
+				newNamespace("app1", nil),
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "oldsecret", Namespace: "app1", Annotations: copyAnnotations("mykey")},
+					Data:       map[string][]byte{"apikey": []byte("mykey")},
+				},
+			},
+			statusNamespaces: []string{"app1"},
+			statusSecret:     "oldsecret",
+			expectNamespaces: []string{"app1"},
+			expectCopies:     []string{"app1"},
+		},
+		{
+			description: "update stale copy",
+			spec:        ibmcloudv1.BindingSpec{TargetNamespaces: []string{"app1"}},
+			objects: []runtime.Object{
+				newNamespace("app1", nil),
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: bindingName, Namespace: "app1", Annotations: copyAnnotations("oldkey")},
+					Data:       map[string][]byte{"apikey": []byte("oldkey")},
+				},
+			},
+			statusNamespaces: []string{"app1"},
+			expectNamespaces: []string{"app1"},
+			expectCopies:     []string{"app1"},
+		},
+		{
+			description: "delete copies no longer targeted",
+			spec:        ibmcloudv1.BindingSpec{TargetNamespaces: []string{"app1"}},
+			objects: []runtime.Object{
+				newNamespace("app1", nil),
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: bindingName, Namespace: "app2", Annotations: copyAnnotations("mykey")},
+					Data:       map[string][]byte{"apikey": []byte("mykey")},
+				},
+			},
+			statusNamespaces: []string{"app1", "app2"},
+			expectNamespaces: []string{"app1"},
+			expectCopies:     []string{"app1"},
+			expectNoCopies:   []string{"app2"},
+		},
+		{
+			description: "existing secret not owned by binding",
+			spec:        ibmcloudv1.BindingSpec{TargetNamespaces: []string{"app1"}},
+			objects: []runtime.Object{
+				newNamespace("app1", nil),
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: bindingName, Namespace: "app1"},
+					Data:       map[string][]byte{"password": []byte("hunter2")},
+				},
+			},
+			expectErr: `secrets "app1/mybinding" already exists`,
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			scheme := schemas(t)
+			recorder := record.NewFakeRecorder(10)
+			r := &BindingReconciler{
+				Client:   fake.NewFakeClientWithScheme(scheme, tc.objects...),
+				Log:      testLogger(t),
+				Scheme:   scheme,
+				Recorder: recorder,
+			}
+			spec := tc.spec
+			spec.ServiceName = "myservice"
+			binding := &ibmcloudv1.Binding{
+				ObjectMeta: metav1.ObjectMeta{Name: bindingName, Namespace: namespace},
+				Spec:       spec,
+				Status: ibmcloudv1.BindingStatus{
+					InstanceID:       "myinstance",
+					KeyInstanceID:    "mykey",
+					TargetNamespaces: tc.statusNamespaces,
+					SecretName:       tc.statusSecret,
+				},
+			}
+
+			err := r.replicateSecret(context.Background(), binding, map[string]interface{}{"apikey": "mykey"})
+			if tc.expectErr != "" {
+				assert.EqualError(t, err, tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectNamespaces, binding.Status.TargetNamespaces)
+			close(recorder.Events)
+			var events []string
+			for event := range recorder.Events {
+				events = append(events, event)
+			}
+			assert.Equal(t, tc.expectEvents, events)
+
+			for _, copyNamespace := range tc.expectCopies {
+				var secret corev1.Secret
+				require.NoError(t, r.Get(context.Background(), types.NamespacedName{Name: bindingName, Namespace: copyNamespace}, &secret))
+				assert.Equal(t, copyAnnotations("mykey"), secret.Annotations)
+				assert.Equal(t, map[string][]byte{"apikey": []byte("mykey")}, secret.Data)
+				assert.Equal(t, corev1.SecretType(""), secret.Type)
+			}
+			if tc.statusSecret != "" {
+				var secret corev1.Secret
+				err := r.Get(context.Background(), types.NamespacedName{Name: tc.statusSecret, Namespace: "app1"}, &secret)
+				assert.Error(t, err, "Copy of the previous secret name should be deleted")
+			}
+			for _, copyNamespace := range tc.expectNoCopies {
+				var secret corev1.Secret
+				err := r.Get(context.Background(), types.NamespacedName{Name: bindingName, Namespace: copyNamespace}, &secret)
+				assert.Error(t, err, "Secret copy should not exist in namespace %s", copyNamespace)
+			}
+		})
+	}
+}
+
+func TestBindingDeleteSecretCopies(t *testing.T) {
+	t.Parallel()
+	scheme := schemas(t)
+	binding := &ibmcloudv1.Binding{
+		ObjectMeta: metav1.ObjectMeta{Name: "mybinding", Namespace: "mynamespace"},
+	}
+	copySecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "mybinding", Namespace: "app1", Annotations: map[string]string{
+			bindingNameAnnotation:      "mybinding",
+			bindingNamespaceAnnotation: "mynamespace",
+		}},
+	}
+	otherSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "mybinding", Namespace: "app2"},
+	}
+	r := &BindingReconciler{
+		Client: fake.NewFakeClientWithScheme(scheme, copySecret, otherSecret),
+		Log:    testLogger(t),
+		Scheme: scheme,
+	}
+
+	require.NoError(t, r.deleteSecretCopies(context.Background(), binding, getSecretName(binding), []string{"app1", "app2", "app3"}))
+
+	var secret corev1.Secret
+	assert.Error(t, r.Get(context.Background(), types.NamespacedName{Name: "mybinding", Namespace: "app1"}, &secret), "Copy should be deleted")
+	assert.NoError(t, r.Get(context.Background(), types.NamespacedName{Name: "mybinding", Namespace: "app2"}, &secret), "Unrelated secret should be kept")
+}
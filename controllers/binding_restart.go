@@ -0,0 +1,143 @@
+package controllers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	ibmcloudv1 "github.com/ibm/cloud-operators/api/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// +kubebuilder:rbac:groups=apps,resources=deployments;statefulsets;daemonsets,verbs=get;list;watch;patch
+// +kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
+
+// restartAnnotation returns the pod template annotation which holds the hash of one of the Binding's secrets when its workloads were last restarted.
+// The key is named after a hash of the Binding's and secret's names, which keeps it within the annotation key length limit for any name.
+func restartAnnotation(instance *ibmcloudv1.Binding, secretName string) string {
+	nameHash := sha256.Sum256([]byte(instance.Name + "/" + secretName))
+	return "binding.ibmcloud.ibm.com/secretHash-" + hex.EncodeToString(nameHash[:16])
+}
+
+// restartWorkloadsIfEnabled restarts the workloads using one of the Binding's secrets if spec.restartWorkloads is set.
+// A failed restart is recorded in a warning Event and does not fail the Binding.
+func (r *BindingReconciler) restartWorkloadsIfEnabled(ctx context.Context, instance *ibmcloudv1.Binding, secretName, hash string) {
+	if !instance.Spec.RestartWorkloads {
+		return
+	}
+	if err := r.restartWorkloads(ctx, instance, secretName, hash); err != nil {
+		r.Log.Error(err, "Failed to restart workloads using the secret", "binding", instance.Name, "secret", secretName)
+		r.Recorder.Eventf(instance, corev1.EventTypeWarning, "RestartWorkloadsFailed", "Failed to restart workloads using secret %s: %v", secretName, err)
+	}
+}
+
+// restartWorkloads rolls the Deployments, StatefulSets and DaemonSets in the Binding's namespace which use one of its secrets,
+// either the Binding's own or a separate secret of additional credentials, by setting the secret's hash on their pod templates.
+// The restarted workloads are recorded in an Event.
+// Workloads are listed with the API reader, restricted to the Binding's namespace, to avoid caching them cluster-wide.
+func (r *BindingReconciler) restartWorkloads(ctx context.Context, instance *ibmcloudv1.Binding, secretName, hash string) error {
+	annotation := restartAnnotation(instance, secretName)
+	injectedBinding := ""
+	if secretName == getSecretName(instance) {
+		// only the Binding's own secret is injected into pods listing the Binding
+		injectedBinding = instance.Name
+	}
+
+	var restarted []string
+	restart := func(kind, name string, obj runtime.Object, template *corev1.PodTemplateSpec) error {
+		if !usesSecret(*template, injectedBinding, secretName) || template.Annotations[annotation] == hash {
+			return nil
+		}
+		patch := client.MergeFrom(obj.DeepCopyObject())
+		if template.Annotations == nil {
+			template.Annotations = make(map[string]string)
+		}
+		template.Annotations[annotation] = hash
+		if err := r.Patch(ctx, obj, patch); err != nil {
+			return err
+		}
+		restarted = append(restarted, fmt.Sprintf("%s/%s", kind, name))
+		return nil
+	}
+
+	inNamespace := client.InNamespace(instance.Namespace)
+	var deployments appsv1.DeploymentList
+	if err := r.APIReader.List(ctx, &deployments, inNamespace); err != nil {
+		return err
+	}
+	for i := range deployments.Items {
+		deployment := &deployments.Items[i]
+		if err := restart("Deployment", deployment.Name, deployment, &deployment.Spec.Template); err != nil {
+			return err
+		}
+	}
+	var statefulSets appsv1.StatefulSetList
+	if err := r.APIReader.List(ctx, &statefulSets, inNamespace); err != nil {
+		return err
+	}
+	for i := range statefulSets.Items {
+		statefulSet := &statefulSets.Items[i]
+		if err := restart("StatefulSet", statefulSet.Name, statefulSet, &statefulSet.Spec.Template); err != nil {
+			return err
+		}
+	}
+	var daemonSets appsv1.DaemonSetList
+	if err := r.APIReader.List(ctx, &daemonSets, inNamespace); err != nil {
+		return err
+	}
+	for i := range daemonSets.Items {
+		daemonSet := &daemonSets.Items[i]
+		if err := restart("DaemonSet", daemonSet.Name, daemonSet, &daemonSet.Spec.Template); err != nil {
+			return err
+		}
+	}
+
+	if len(restarted) > 0 {
+		sort.Strings(restarted)
+		r.Recorder.Eventf(instance, corev1.EventTypeNormal, "RestartedWorkloads", "Restarted workloads using secret %s: %s", secretName, strings.Join(restarted, ", "))
+	}
+	return nil
+}
+
+// usesSecret returns true if the pod template references the secret from an environment variable or a volume,
+// or lists the Binding in its ibmcloud.ibm.com/bindings annotation for injection. bindingName is empty for secrets which are not injected.
+func usesSecret(template corev1.PodTemplateSpec, bindingName, secretName string) bool {
+	for _, name := range parseBindingsAnnotation(template.Annotations[bindingsAnnotation]) {
+		if bindingName != "" && name == bindingName {
+			return true
+		}
+	}
+	spec := template.Spec
+	for _, volume := range spec.Volumes {
+		if volume.Secret != nil && volume.Secret.SecretName == secretName {
+			return true
+		}
+		if volume.Projected != nil {
+			for _, source := range volume.Projected.Sources {
+				if source.Secret != nil && source.Secret.Name == secretName {
+					return true
+				}
+			}
+		}
+	}
+	containers := append(append([]corev1.Container{}, spec.InitContainers...), spec.Containers...)
+	for _, container := range containers {
+		for _, envFrom := range container.EnvFrom {
+			if envFrom.SecretRef != nil && envFrom.SecretRef.Name == secretName {
+				return true
+			}
+		}
+		for _, env := range container.Env {
+			if env.ValueFrom != nil && env.ValueFrom.SecretKeyRef != nil && env.ValueFrom.SecretKeyRef.Name == secretName {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,238 @@
+package controllers
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	ibmcloudv1 "github.com/ibm/cloud-operators/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestUsesSecret(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		description string
+		annotations map[string]string
+		spec        corev1.PodSpec
+		expect      bool
+	}{
+		{
+			description: "no references",
+			spec:        corev1.PodSpec{Containers: []corev1.Container{{Name: "app"}}},
+		},
+		{
+			description: "secret volume",
+			spec: corev1.PodSpec{Volumes: []corev1.Volume{
+				{VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "mysecret"}}},
+			}},
+			expect: true,
+		},
+		{
+			description: "projected volume",
+			spec: corev1.PodSpec{Volumes: []corev1.Volume{
+				{VolumeSource: corev1.VolumeSource{Projected: &corev1.ProjectedVolumeSource{Sources: []corev1.VolumeProjection{
+					{Secret: &corev1.SecretProjection{LocalObjectReference: corev1.LocalObjectReference{Name: "mysecret"}}},
+				}}}},
+			}},
+			expect: true,
+		},
+		{
+			description: "envFrom in init container",
+			spec: corev1.PodSpec{InitContainers: []corev1.Container{
+				{EnvFrom: []corev1.EnvFromSource{{SecretRef: &corev1.SecretEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "mysecret"}}}}},
+			}},
+			expect: true,
+		},
+		{
+			description: "env secret key",
+			spec: corev1.PodSpec{Containers: []corev1.Container{
+				{Env: []corev1.EnvVar{{Name: "APIKEY", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "mysecret"},
+					Key:                  "apikey",
+				}}}}},
+			}},
+			expect: true,
+		},
+		{
+			description: "injected binding",
+			annotations: map[string]string{bindingsAnnotation: "otherbinding, mybinding"},
+			expect:      true,
+		},
+		{
+			description: "other injected binding",
+			annotations: map[string]string{bindingsAnnotation: "otherbinding"},
+		},
+		{
+			description: "other secret",
+			spec: corev1.PodSpec{Volumes: []corev1.Volume{
+				{VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "othersecret"}}},
+			}},
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			template := corev1.PodTemplateSpec{ObjectMeta: metav1.ObjectMeta{Annotations: tc.annotations}, Spec: tc.spec}
+			assert.Equal(t, tc.expect, usesSecret(template, "mybinding", "mysecret"))
+		})
+	}
+}
+
+func TestBindingRestartWorkloads(t *testing.T) {
+	t.Parallel()
+	const (
+		namespace = "mynamespace"
+		hash      = "newhash"
+	)
+	scheme := schemas(t)
+	require.NoError(t, appsv1.AddToScheme(scheme))
+
+	binding := &ibmcloudv1.Binding{
+		ObjectMeta: metav1.ObjectMeta{Name: "mybinding", Namespace: namespace},
+		Spec:       ibmcloudv1.BindingSpec{SecretName: "mysecret", RestartWorkloads: true},
+		Status:     ibmcloudv1.BindingStatus{SecretHash: hash},
+	}
+	annotation := restartAnnotation(binding, "mysecret")
+	secretVolume := []corev1.Volume{
+		{VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "mysecret"}}},
+	}
+	podTemplate := func(annotations map[string]string, volumes []corev1.Volume) corev1.PodTemplateSpec {
+		return corev1.PodTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{Annotations: annotations},
+			Spec:       corev1.PodSpec{Volumes: volumes},
+		}
+	}
+
+	client := fake.NewFakeClientWithScheme(scheme,
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "stale", Namespace: namespace},
+			Spec:       appsv1.DeploymentSpec{Template: podTemplate(map[string]string{annotation: "oldhash"}, secretVolume)},
+		},
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "current", Namespace: namespace},
+			Spec:       appsv1.DeploymentSpec{Template: podTemplate(map[string]string{annotation: hash}, secretVolume)},
+		},
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "unrelated", Namespace: namespace},
+			Spec:       appsv1.DeploymentSpec{Template: podTemplate(nil, nil)},
+		},
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "othernamespace", Namespace: "othernamespace"},
+			Spec:       appsv1.DeploymentSpec{Template: podTemplate(nil, secretVolume)},
+		},
+		&appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "mystatefulset", Namespace: namespace},
+			Spec:       appsv1.StatefulSetSpec{Template: podTemplate(nil, secretVolume)},
+		},
+		&appsv1.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "mydaemonset", Namespace: namespace},
+			Spec:       appsv1.DaemonSetSpec{Template: podTemplate(nil, secretVolume)},
+		},
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "injected", Namespace: namespace},
+			Spec:       appsv1.DeploymentSpec{Template: podTemplate(map[string]string{bindingsAnnotation: "mybinding"}, nil)},
+		},
+	)
+	r := &BindingReconciler{
+		Client:    client,
+		APIReader: client,
+		Log:       testLogger(t),
+		Scheme:    scheme,
+		Recorder:  record.NewFakeRecorder(10),
+	}
+
+	require.NoError(t, r.restartWorkloads(context.Background(), binding, "mysecret", hash))
+
+	deploymentAnnotations := func(name, namespace string) map[string]string {
+		var deployment appsv1.Deployment
+		require.NoError(t, r.Get(context.Background(), types.NamespacedName{Name: name, Namespace: namespace}, &deployment))
+		return deployment.Spec.Template.Annotations
+	}
+	assert.Equal(t, hash, deploymentAnnotations("stale", namespace)[annotation])
+	assert.Equal(t, hash, deploymentAnnotations("injected", namespace)[annotation])
+	assert.NotContains(t, deploymentAnnotations("unrelated", namespace), annotation)
+	assert.NotContains(t, deploymentAnnotations("othernamespace", "othernamespace"), annotation)
+
+	var statefulSet appsv1.StatefulSet
+	require.NoError(t, r.Get(context.Background(), types.NamespacedName{Name: "mystatefulset", Namespace: namespace}, &statefulSet))
+	assert.Equal(t, hash, statefulSet.Spec.Template.Annotations[annotation])
+	var daemonSet appsv1.DaemonSet
+	require.NoError(t, r.Get(context.Background(), types.NamespacedName{Name: "mydaemonset", Namespace: namespace}, &daemonSet))
+	assert.Equal(t, hash, daemonSet.Spec.Template.Annotations[annotation])
+
+	events := r.Recorder.(*record.FakeRecorder).Events
+	require.Len(t, events, 1)
+	assert.Equal(t, "Normal RestartedWorkloads Restarted workloads using secret mysecret: DaemonSet/mydaemonset, Deployment/injected, Deployment/stale, StatefulSet/mystatefulset", <-events)
+}
+
+func TestRestartAnnotation(t *testing.T) {
+	t.Parallel()
+	for _, name := range []string{"mybinding", strings.Repeat("a", 253)} {
+		annotation := restartAnnotation(&ibmcloudv1.Binding{ObjectMeta: metav1.ObjectMeta{Name: name}}, name)
+		assert.Empty(t, validation.IsQualifiedName(annotation), "Annotation key %q should be valid", annotation)
+	}
+	binding := &ibmcloudv1.Binding{ObjectMeta: metav1.ObjectMeta{Name: "mybinding"}}
+	assert.NotEqual(t,
+		restartAnnotation(binding, "mysecret"),
+		restartAnnotation(&ibmcloudv1.Binding{ObjectMeta: metav1.ObjectMeta{Name: "otherbinding"}}, "mysecret"),
+	)
+	assert.NotEqual(t, restartAnnotation(binding, "mysecret"), restartAnnotation(binding, "othersecret"), "Each secret should have its own annotation")
+}
+
+func TestRestartWorkloadsAdditionalCredentialsSecret(t *testing.T) {
+	t.Parallel()
+	const namespace = "mynamespace"
+	scheme := schemas(t)
+	require.NoError(t, appsv1.AddToScheme(scheme))
+
+	binding := &ibmcloudv1.Binding{
+		ObjectMeta: metav1.ObjectMeta{Name: "mybinding", Namespace: namespace},
+		Spec:       ibmcloudv1.BindingSpec{RestartWorkloads: true},
+	}
+	podTemplate := func(annotations map[string]string, secretName string) corev1.PodTemplateSpec {
+		return corev1.PodTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{Annotations: annotations},
+			Spec: corev1.PodSpec{Containers: []corev1.Container{{
+				EnvFrom: []corev1.EnvFromSource{{SecretRef: &corev1.SecretEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: secretName}}}},
+			}}},
+		}
+	}
+	client := fake.NewFakeClientWithScheme(scheme,
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "analytics", Namespace: namespace},
+			Spec:       appsv1.DeploymentSpec{Template: podTemplate(nil, "analytics-secret")},
+		},
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "injected", Namespace: namespace},
+			Spec:       appsv1.DeploymentSpec{Template: podTemplate(map[string]string{bindingsAnnotation: "mybinding"}, "")},
+		},
+	)
+	r := &BindingReconciler{
+		Client:    client,
+		APIReader: client,
+		Log:       testLogger(t),
+		Scheme:    scheme,
+		Recorder:  record.NewFakeRecorder(10),
+	}
+
+	r.restartWorkloadsIfEnabled(context.Background(), binding, "analytics-secret", "newhash")
+
+	annotation := restartAnnotation(binding, "analytics-secret")
+	var deployment appsv1.Deployment
+	require.NoError(t, r.Get(context.Background(), types.NamespacedName{Name: "analytics", Namespace: namespace}, &deployment))
+	assert.Equal(t, "newhash", deployment.Spec.Template.Annotations[annotation])
+	var injected appsv1.Deployment
+	require.NoError(t, r.Get(context.Background(), types.NamespacedName{Name: "injected", Namespace: namespace}, &injected))
+	assert.NotContains(t, injected.Spec.Template.Annotations, annotation, "Only the Binding's own secret is injected")
+
+	events := r.Recorder.(*record.FakeRecorder).Events
+	require.Len(t, events, 1)
+	assert.Equal(t, "Normal RestartedWorkloads Restarted workloads using secret analytics-secret: Deployment/analytics", <-events)
+}
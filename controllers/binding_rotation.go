@@ -0,0 +1,121 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/bluemix-go/session"
+	ibmcloudv1 "github.com/ibm/cloud-operators/api/v1"
+)
+
+// rotationDue returns true if the Binding's credentials should be replaced with new ones
+func rotationDue(instance *ibmcloudv1.Binding) bool {
+	rotation := instance.Spec.Rotation
+	if rotation == nil || rotation.Interval.Duration <= 0 || instance.Spec.Alias != "" {
+		return false
+	}
+	last := instance.CreationTimestamp
+	if instance.Status.LastRotated != nil {
+		last = *instance.Status.LastRotated
+	}
+	return now().Sub(last.Time) >= rotation.Interval.Duration
+}
+
+// checkRotationSupported returns an error if the Binding sets rotation for a CF service.
+// The webhook rejects these, unless the Service could not be found when the Binding was created.
+func checkRotationSupported(instance *ibmcloudv1.Binding, serviceClassType string) error {
+	if instance.Spec.Rotation != nil && serviceClassType == "CF" {
+		return fmt.Errorf("rotation is not supported for CF services")
+	}
+	return nil
+}
+
+// previousCredentialsExpired returns true if the rotated credentials are past their grace period
+func previousCredentialsExpired(instance *ibmcloudv1.Binding) bool {
+	if instance.Status.PreviousKeyInstanceID == "" {
+		return false
+	}
+	if instance.Spec.Rotation == nil || instance.Status.LastRotated == nil {
+		return true
+	}
+	return now().Sub(instance.Status.LastRotated.Time) >= instance.Spec.Rotation.GracePeriod.Duration
+}
+
+// parametersChanged returns true if the resolved parameters no longer match those the credentials were created with,
+// for example after a referenced secret or configmap was edited.
+// Bindings without a recorded hash record the current one instead.
+// The parameters of additional credentials are checked with credentialsParametersChanged.
+func (r *BindingReconciler) parametersChanged(ctx context.Context, instance *ibmcloudv1.Binding) (bool, error) {
+	if instance.Spec.Alias != "" {
+		return false, nil
+	}
+	return r.paramsHashChanged(ctx, instance.Spec.Parameters, instance.Namespace, &instance.Status.ParametersHash)
+}
+
+// credentialsParametersChanged returns true if the resolved parameters of additional credentials no longer match those they were created with.
+// Credentials without a recorded hash record the current one instead.
+func (r *BindingReconciler) credentialsParametersChanged(ctx context.Context, instance *ibmcloudv1.Binding, credentials ibmcloudv1.BindingCredentials, status *ibmcloudv1.BindingCredentialsStatus) (bool, error) {
+	return r.paramsHashChanged(ctx, credentials.Parameters, instance.Namespace, &status.ParametersHash)
+}
+
+// noParametersHash is recorded for credentials created without parameters, so that adding parameters later counts as a change
+const noParametersHash = "none"
+
+// credentialsParametersHash returns the hash recorded for credentials created with the resolved parameters
+func credentialsParametersHash(ctx context.Context, hashKey *HashKey, params map[string]interface{}) (string, error) {
+	paramsHash, err := parametersHash(ctx, hashKey, params)
+	if paramsHash == "" && err == nil {
+		paramsHash = noParametersHash
+	}
+	return paramsHash, err
+}
+
+// paramsHashChanged returns true if the hash of the resolved parameters differs from recordedHash, or records the hash if it is empty.
+// An empty recorded hash means the credentials were created by an earlier version of the operator, which did not record one.
+func (r *BindingReconciler) paramsHashChanged(ctx context.Context, params []ibmcloudv1.Param, namespace string, recordedHash *string) (bool, error) {
+	parameters, err := r.getParams(ctx, params, namespace)
+	if err != nil {
+		return false, err
+	}
+	paramsHash, err := credentialsParametersHash(ctx, r.HashKey, parameters)
+	if err != nil {
+		return false, err
+	}
+	if *recordedHash == "" {
+		*recordedHash = paramsHash
+		return false, nil
+	}
+	return *recordedHash != paramsHash, nil
+}
+
+// rotateCredentials creates new credentials for a non-CF service, keeping the previous key ID in the status until it expires.
+// The key IDs are written to the status right away, so that a failure in a later step does not lose track of the new key.
+// If they cannot be written, the new key is deleted instead.
+func (r *BindingReconciler) rotateCredentials(ctx context.Context, session *session.Session, instance *ibmcloudv1.Binding) (map[string]interface{}, error) {
+	previous := instance.Status.DeepCopy()
+	keyInstanceID, keyContents, err := r.createCredentials(ctx, session, instance, "")
+	if err != nil {
+		return nil, err
+	}
+	rotated := now()
+	instance.Status.PreviousKeyInstanceID = instance.Status.KeyInstanceID
+	instance.Status.KeyInstanceID = keyInstanceID
+	instance.Status.LastRotated = &rotated
+	if err := r.Status().Update(ctx, instance); err != nil {
+		instance.Status = *previous
+		if deleteErr := r.DeleteResourceServiceKey(session, keyInstanceID); deleteErr != nil {
+			r.Log.Error(deleteErr, "Failed to delete unrecorded credentials", "KeyInstanceID", keyInstanceID)
+		}
+		return nil, err
+	}
+	return keyContents, nil
+}
+
+// deletePreviousCredentials deletes the rotated credentials of a non-CF service
+func (r *BindingReconciler) deletePreviousCredentials(session *session.Session, instance *ibmcloudv1.Binding) error {
+	if err := r.DeleteResourceServiceKey(session, instance.Status.PreviousKeyInstanceID); err != nil {
+		return err
+	}
+	instance.Status.PreviousKeyInstanceID = ""
+	return nil
+}
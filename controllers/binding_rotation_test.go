@@ -0,0 +1,399 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/IBM-Cloud/bluemix-go/crn"
+	"github.com/IBM-Cloud/bluemix-go/session"
+	"github.com/go-logr/logr"
+	ibmcloudv1 "github.com/ibm/cloud-operators/api/v1"
+	"github.com/ibm/cloud-operators/internal/ibmcloud"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestRotationDue(t *testing.T) {
+	t.Parallel()
+	daysAgo := func(days int) *metav1.Time {
+		t := metav1.NewTime(testConditionTime.Add(-time.Duration(days) * 24 * time.Hour))
+		return &t
+	}
+	rotation := &ibmcloudv1.BindingRotation{Interval: metav1.Duration{Duration: 90 * 24 * time.Hour}}
+
+	for _, tc := range []struct {
+		description string
+		binding     ibmcloudv1.Binding
+		expectDue   bool
+	}{
+		{
+			description: "no rotation",
+			binding: ibmcloudv1.Binding{
+				ObjectMeta: metav1.ObjectMeta{CreationTimestamp: *daysAgo(365)},
+			},
+			expectDue: false,
+		},
+		{
+			description: "never rotated and created recently",
+			binding: ibmcloudv1.Binding{
+				ObjectMeta: metav1.ObjectMeta{CreationTimestamp: *daysAgo(10)},
+				Spec:       ibmcloudv1.BindingSpec{Rotation: rotation},
+			},
+			expectDue: false,
+		},
+		{
+			description: "never rotated and created before the interval",
+			binding: ibmcloudv1.Binding{
+				ObjectMeta: metav1.ObjectMeta{CreationTimestamp: *daysAgo(90)},
+				Spec:       ibmcloudv1.BindingSpec{Rotation: rotation},
+			},
+			expectDue: true,
+		},
+		{
+			description: "rotated recently",
+			binding: ibmcloudv1.Binding{
+				ObjectMeta: metav1.ObjectMeta{CreationTimestamp: *daysAgo(365)},
+				Spec:       ibmcloudv1.BindingSpec{Rotation: rotation},
+				Status:     ibmcloudv1.BindingStatus{LastRotated: daysAgo(1)},
+			},
+			expectDue: false,
+		},
+		{
+			description: "alias",
+			binding: ibmcloudv1.Binding{
+				ObjectMeta: metav1.ObjectMeta{CreationTimestamp: *daysAgo(365)},
+				Spec:       ibmcloudv1.BindingSpec{Alias: "mycredentials", Rotation: rotation},
+			},
+			expectDue: false,
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			assert.Equal(t, tc.expectDue, rotationDue(&tc.binding))
+		})
+	}
+}
+
+func TestPreviousCredentialsExpired(t *testing.T) {
+	t.Parallel()
+	hoursAgo := func(hours int) *metav1.Time {
+		t := metav1.NewTime(testConditionTime.Add(-time.Duration(hours) * time.Hour))
+		return &t
+	}
+	rotation := &ibmcloudv1.BindingRotation{
+		Interval:    metav1.Duration{Duration: 90 * 24 * time.Hour},
+		GracePeriod: metav1.Duration{Duration: 24 * time.Hour},
+	}
+
+	assert.False(t, previousCredentialsExpired(&ibmcloudv1.Binding{
+		Spec: ibmcloudv1.BindingSpec{Rotation: rotation},
+	}), "No previous credentials")
+	assert.False(t, previousCredentialsExpired(&ibmcloudv1.Binding{
+		Spec:   ibmcloudv1.BindingSpec{Rotation: rotation},
+		Status: ibmcloudv1.BindingStatus{PreviousKeyInstanceID: "oldkey", LastRotated: hoursAgo(1)},
+	}), "Within grace period")
+	assert.True(t, previousCredentialsExpired(&ibmcloudv1.Binding{
+		Spec:   ibmcloudv1.BindingSpec{Rotation: rotation},
+		Status: ibmcloudv1.BindingStatus{PreviousKeyInstanceID: "oldkey", LastRotated: hoursAgo(24)},
+	}), "Grace period ended")
+	assert.True(t, previousCredentialsExpired(&ibmcloudv1.Binding{
+		Status: ibmcloudv1.BindingStatus{PreviousKeyInstanceID: "oldkey", LastRotated: hoursAgo(1)},
+	}), "Rotation disabled")
+}
+
+func TestBindingParametersChanged(t *testing.T) {
+	t.Parallel()
+	const namespace = "mynamespace"
+	scheme := schemas(t)
+	r := &BindingReconciler{
+		Client: fake.NewFakeClientWithScheme(scheme, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "mysecret", Namespace: namespace},
+			Data:       map[string][]byte{"password": []byte("newpassword")},
+		}),
+		Log:    testLogger(t),
+		Scheme: scheme,
+	}
+	params := []ibmcloudv1.Param{{
+		Name: "password",
+		ValueFrom: &ibmcloudv1.ParamSource{
+			SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "mysecret"}, Key: "password"},
+		},
+	}}
+	expectHash, err := parametersHash(context.Background(), nil, map[string]interface{}{"password": "newpassword"})
+	require.NoError(t, err)
+
+	binding := &ibmcloudv1.Binding{
+		ObjectMeta: metav1.ObjectMeta{Name: "mybinding", Namespace: namespace},
+		Spec:       ibmcloudv1.BindingSpec{Parameters: params},
+		Status:     ibmcloudv1.BindingStatus{ParametersHash: "oldhash"},
+	}
+	changed, err := r.parametersChanged(context.Background(), binding)
+	assert.NoError(t, err)
+	assert.True(t, changed)
+
+	binding.Status.ParametersHash = expectHash
+	changed, err = r.parametersChanged(context.Background(), binding)
+	assert.NoError(t, err)
+	assert.False(t, changed)
+
+	binding.Status.ParametersHash = ""
+	changed, err = r.parametersChanged(context.Background(), binding)
+	assert.NoError(t, err)
+	assert.False(t, changed, "Bindings without a hash should not recreate their credentials")
+	assert.Equal(t, expectHash, binding.Status.ParametersHash)
+
+	binding.Spec.Parameters = nil
+	binding.Status.ParametersHash = ""
+	changed, err = r.parametersChanged(context.Background(), binding)
+	assert.NoError(t, err)
+	assert.False(t, changed)
+	assert.Equal(t, noParametersHash, binding.Status.ParametersHash, "Bindings without parameters should record that they have none")
+
+	binding.Spec.Parameters = params
+	changed, err = r.parametersChanged(context.Background(), binding)
+	assert.NoError(t, err)
+	assert.True(t, changed, "Adding parameters to a Binding without any should recreate its credentials")
+
+	binding.Spec.Parameters[0].ValueFrom.SecretKeyRef.Name = "othersecret"
+	_, err = r.parametersChanged(context.Background(), binding)
+	assert.EqualError(t, err, "Missing secret othersecret")
+}
+
+func TestBindingRotateCredentials(t *testing.T) {
+	t.Parallel()
+	const (
+		namespace   = "mynamespace"
+		bindingName = "mybinding"
+		serviceName = "myservice"
+		instanceID  = "myinstanceid"
+	)
+	lastRotated := metav1.NewTime(testConditionTime.Add(-100 * 24 * time.Hour))
+	rotatedRecently := metav1.NewTime(testConditionTime.Add(-2 * time.Hour))
+	rotation := &ibmcloudv1.BindingRotation{
+		Interval:    metav1.Duration{Duration: 90 * 24 * time.Hour},
+		GracePeriod: metav1.Duration{Duration: time.Hour},
+	}
+
+	for _, tc := range []struct {
+		description       string
+		status            ibmcloudv1.BindingStatus
+		expectCreated     bool
+		expectDeletedKeys []string
+		expectStatus      ibmcloudv1.BindingStatus
+	}{
+		{
+			description: "rotation due",
+			status: ibmcloudv1.BindingStatus{
+				State: bindingStateOnline, InstanceID: instanceID, KeyInstanceID: "oldkey", SecretName: bindingName, LastRotated: &lastRotated,
+			},
+			expectCreated: true,
+			expectStatus: ibmcloudv1.BindingStatus{
+				KeyInstanceID: "newkey", PreviousKeyInstanceID: "oldkey", LastRotated: &testConditionTime,
+			},
+		},
+		{
+			description: "previous credentials expired",
+			status: ibmcloudv1.BindingStatus{
+				State: bindingStateOnline, InstanceID: instanceID, KeyInstanceID: "newkey", PreviousKeyInstanceID: "oldkey", SecretName: bindingName, LastRotated: &rotatedRecently,
+			},
+			expectDeletedKeys: []string{"oldkey"},
+			expectStatus: ibmcloudv1.BindingStatus{
+				KeyInstanceID: "newkey", LastRotated: &rotatedRecently,
+			},
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			scheme := schemas(t)
+			objects := []runtime.Object{
+				&ibmcloudv1.Binding{
+					ObjectMeta: metav1.ObjectMeta{Name: bindingName, Namespace: namespace, Finalizers: []string{bindingFinalizer}},
+					Spec:       ibmcloudv1.BindingSpec{ServiceName: serviceName, Rotation: rotation},
+					Status:     tc.status,
+				},
+				&ibmcloudv1.Service{
+					ObjectMeta: metav1.ObjectMeta{Name: serviceName, Namespace: namespace},
+					Status:     ibmcloudv1.ServiceStatus{InstanceID: instanceID},
+				},
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        bindingName,
+						Namespace:   namespace,
+						Annotations: map[string]string{"service-key-id": tc.status.KeyInstanceID},
+					},
+					Data: map[string][]byte{"apikey": []byte(tc.status.KeyInstanceID)},
+				},
+			}
+			var created bool
+			var deletedKeys []string
+			r := &BindingReconciler{
+				Client: newMockClient(
+					fake.NewFakeClientWithScheme(scheme, objects...),
+					MockConfig{},
+				),
+				Log:    testLogger(t),
+				Scheme: scheme,
+
+				GetIBMCloudInfo: func(logt logr.Logger, _ client.Client, instance *ibmcloudv1.Service, _ bool) (*ibmcloud.Info, error) {
+					return &ibmcloud.Info{}, nil
+				},
+				SetControllerReference: func(owner, controlled metav1.Object, scheme *runtime.Scheme) error {
+					return nil
+				},
+				SetOwnerReference: func(owner, controlled metav1.Object, scheme *runtime.Scheme) error {
+					return nil
+				},
+				GetResourceServiceKey: func(session *session.Session, keyID string) (string, string, map[string]interface{}, error) {
+					return keyID, bindingName, map[string]interface{}{"apikey": keyID}, nil
+				},
+				GetServiceInstanceCRN: func(session *session.Session, instanceID string) (crn.CRN, string, error) {
+					return crn.CRN{}, "", nil
+				},
+				GetServiceName: func(session *session.Session, serviceID string) (string, error) {
+					return "", nil
+				},
+				GetServiceRoleCRN: func(session *session.Session, serviceName, roleName string) (crn.CRN, error) {
+					return crn.CRN{}, nil
+				},
+				CreateResourceServiceKey: func(session *session.Session, name string, crn crn.CRN, parameters map[string]interface{}) (string, map[string]interface{}, error) {
+					created = true
+					return "newkey", map[string]interface{}{"apikey": "newkey"}, nil
+				},
+				DeleteResourceServiceKey: func(session *session.Session, serviceKeyGUID string) error {
+					deletedKeys = append(deletedKeys, serviceKeyGUID)
+					return nil
+				},
+			}
+
+			_, err := r.Reconcile(ctrl.Request{
+				NamespacedName: types.NamespacedName{Name: bindingName, Namespace: namespace},
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectCreated, created)
+			assert.Equal(t, tc.expectDeletedKeys, deletedKeys)
+
+			status := r.Client.(MockClient).LastStatusUpdate().(*ibmcloudv1.Binding).Status
+			assert.Equal(t, bindingStateOnline, status.State)
+			assert.Equal(t, tc.expectStatus.KeyInstanceID, status.KeyInstanceID)
+			assert.Equal(t, tc.expectStatus.PreviousKeyInstanceID, status.PreviousKeyInstanceID)
+			assert.True(t, tc.expectStatus.LastRotated.Equal(status.LastRotated))
+
+			if tc.expectCreated {
+				secret := r.Client.(MockClient).LastUpdate().(*corev1.Secret)
+				assert.Equal(t, "newkey", secret.Annotations["service-key-id"])
+				assert.Equal(t, []byte("newkey"), secret.Data["apikey"])
+			}
+		})
+	}
+}
+
+func TestBindingRotateCredentialsLaterStepFailed(t *testing.T) {
+	t.Parallel()
+	const (
+		namespace        = "mynamespace"
+		serviceNamespace = "servicenamespace"
+		bindingName      = "mybinding"
+		serviceName      = "myservice"
+		instanceID       = "myinstanceid"
+	)
+	lastRotated := metav1.NewTime(testConditionTime.Add(-100 * 24 * time.Hour))
+
+	for _, tc := range []struct {
+		description       string
+		mockConfig        MockConfig
+		expectDeletedKeys []string
+		expectStatus      *ibmcloudv1.BindingStatus
+	}{
+		{
+			description: "secret update failed",
+			mockConfig:  MockConfig{UpdateErr: errors.New("failed")},
+			expectStatus: &ibmcloudv1.BindingStatus{
+				KeyInstanceID: "newkey", PreviousKeyInstanceID: "oldkey", LastRotated: &testConditionTime,
+			},
+		},
+		{
+			description:       "status update failed",
+			mockConfig:        MockConfig{StatusUpdateErr: errors.New("failed")},
+			expectDeletedKeys: []string{"newkey"},
+		},
+	} {
+		tc := tc // capture range variable
+		t.Run(tc.description, func(t *testing.T) {
+			t.Parallel()
+			scheme := schemas(t)
+			objects := []runtime.Object{
+				&ibmcloudv1.Binding{
+					ObjectMeta: metav1.ObjectMeta{Name: bindingName, Namespace: namespace, Finalizers: []string{bindingFinalizer}},
+					// the service is in another namespace, so the only update is the secret's
+					Spec: ibmcloudv1.BindingSpec{ServiceName: serviceName, ServiceNamespace: serviceNamespace, Rotation: &ibmcloudv1.BindingRotation{
+						Interval: metav1.Duration{Duration: 90 * 24 * time.Hour},
+					}},
+					Status: ibmcloudv1.BindingStatus{
+						State: bindingStateFailed, InstanceID: instanceID, KeyInstanceID: "oldkey", SecretName: bindingName, LastRotated: &lastRotated,
+					},
+				},
+				&ibmcloudv1.Service{
+					ObjectMeta: metav1.ObjectMeta{Name: serviceName, Namespace: serviceNamespace},
+					Spec:       ibmcloudv1.ServiceSpec{AllowedBindingNamespaces: []string{namespace}},
+					Status:     ibmcloudv1.ServiceStatus{InstanceID: instanceID},
+				},
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        bindingName,
+						Namespace:   namespace,
+						Annotations: map[string]string{"service-key-id": "oldkey"},
+					},
+					Data: map[string][]byte{"apikey": []byte("oldkey")},
+				},
+			}
+			var deletedKeys []string
+			r := &BindingReconciler{
+				Client: newMockClient(fake.NewFakeClientWithScheme(scheme, objects...), tc.mockConfig),
+				Log:    testLogger(t),
+				Scheme: scheme,
+
+				GetIBMCloudInfo: func(logt logr.Logger, _ client.Client, instance *ibmcloudv1.Service, _ bool) (*ibmcloud.Info, error) {
+					return &ibmcloud.Info{}, nil
+				},
+				GetResourceServiceKey: func(session *session.Session, keyID string) (string, string, map[string]interface{}, error) {
+					return keyID, bindingName, map[string]interface{}{"apikey": keyID}, nil
+				},
+				GetServiceInstanceCRN: func(session *session.Session, instanceID string) (crn.CRN, string, error) {
+					return crn.CRN{}, "", nil
+				},
+				GetServiceName: func(session *session.Session, serviceID string) (string, error) {
+					return "", nil
+				},
+				GetServiceRoleCRN: func(session *session.Session, serviceName, roleName string) (crn.CRN, error) {
+					return crn.CRN{}, nil
+				},
+				CreateResourceServiceKey: func(session *session.Session, name string, crn crn.CRN, parameters map[string]interface{}) (string, map[string]interface{}, error) {
+					return "newkey", map[string]interface{}{"apikey": "newkey"}, nil
+				},
+				DeleteResourceServiceKey: func(session *session.Session, serviceKeyGUID string) error {
+					deletedKeys = append(deletedKeys, serviceKeyGUID)
+					return nil
+				},
+			}
+
+			_, err := r.Reconcile(ctrl.Request{
+				NamespacedName: types.NamespacedName{Name: bindingName, Namespace: namespace},
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectDeletedKeys, deletedKeys)
+			if tc.expectStatus != nil {
+				status := r.Client.(MockClient).LastStatusUpdate().(*ibmcloudv1.Binding).Status
+				assert.Equal(t, tc.expectStatus.KeyInstanceID, status.KeyInstanceID, "Rotated key should be recorded before the secret is updated")
+				assert.Equal(t, tc.expectStatus.PreviousKeyInstanceID, status.PreviousKeyInstanceID)
+				assert.True(t, tc.expectStatus.LastRotated.Equal(status.LastRotated))
+			}
+		})
+	}
+}
@@ -0,0 +1,162 @@
+/*
+ * Copyright 2021 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"context"
+	"net/http"
+	"sort"
+
+	ibmcloudv1 "github.com/ibm/cloud-operators/api/v1"
+	"github.com/ibm/cloud-operators/internal/certificates"
+	"github.com/ibm/cloud-operators/internal/secrettemplate"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+const bindingValidatorPath = "/validate-ibmcloud-ibm-com-v1-binding"
+
+// +kubebuilder:webhook:path=/validate-ibmcloud-ibm-com-v1-binding,mutating=false,failurePolicy=fail,sideEffects=None,groups=ibmcloud.ibm.com,resources=bindings,verbs=create;update,versions=v1,name=vbinding.ibmcloud.ibm.com,admissionReviewVersions=v1beta1
+
+// BindingValidator rejects invalid Bindings on creation and update.
+// Besides the spec checks in the API package, it parses secret templates and certificate JSONPaths,
+// and looks up the bound Service to decide whether alias credentials need a key ID and whether rotation is supported.
+type BindingValidator struct {
+	Client client.Reader
+
+	decoder *admission.Decoder
+}
+
+var _ admission.Handler = &BindingValidator{}
+
+// Handle validates the Binding in the request
+func (v *BindingValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	binding := &ibmcloudv1.Binding{}
+	if err := v.decoder.Decode(req, binding); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	if req.Operation == admissionv1beta1.Update && !binding.DeletionTimestamp.IsZero() {
+		// never block the updates which remove finalizers
+		return admission.Allowed("")
+	}
+	if binding.Namespace == "" {
+		binding.Namespace = req.Namespace
+	}
+	if err := v.validate(ctx, binding); err != nil {
+		return admission.Denied(err.Error())
+	}
+	return admission.Allowed("")
+}
+
+// InjectDecoder implements admission.DecoderInjector
+func (v *BindingValidator) InjectDecoder(decoder *admission.Decoder) error {
+	v.decoder = decoder
+	return nil
+}
+
+func (v *BindingValidator) validate(ctx context.Context, binding *ibmcloudv1.Binding) error {
+	specPath := field.NewPath("spec")
+	allErrs := binding.ValidateSpec()
+	allErrs = append(allErrs, validateSecretTemplate(specPath.Child("secretTemplate"), binding.Spec.SecretTemplate)...)
+	allErrs = append(allErrs, validateCertificateJSONPaths(specPath.Child("certificates"), binding.Spec.Certificates)...)
+	if binding.Spec.Alias != "" && v.requiresKeyID(ctx, binding) {
+		if _, ok := binding.Annotations[ibmcloudv1.KeyIDAnnotation]; !ok {
+			allErrs = append(allErrs, field.Required(
+				field.NewPath("metadata", "annotations").Key(ibmcloudv1.KeyIDAnnotation),
+				"alias credentials for non-CF services require the credentials' key ID",
+			))
+		}
+	}
+	if binding.Spec.Rotation != nil && v.isCFService(ctx, binding) {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("rotation"), "rotation is not supported for CF services"))
+	}
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(ibmcloudv1.GroupVersion.WithKind("Binding").GroupKind(), binding.Name, allErrs)
+}
+
+// requiresKeyID returns true if the Binding names an instance CRN, or if the bound Service exists and is not a CF service.
+// If the Service can't be found yet, the controller reports any missing key ID instead.
+func (v *BindingValidator) requiresKeyID(ctx context.Context, binding *ibmcloudv1.Binding) bool {
+	if binding.Spec.InstanceCRN != "" {
+		return true
+	}
+	service, found := v.getService(ctx, binding)
+	return found && service.Spec.ServiceClassType != "CF"
+}
+
+// isCFService returns true if the bound Service exists and is a CF service.
+// If the Service can't be found yet, the controller reports any unsupported settings instead.
+func (v *BindingValidator) isCFService(ctx context.Context, binding *ibmcloudv1.Binding) bool {
+	if binding.Spec.InstanceCRN != "" {
+		return false
+	}
+	service, found := v.getService(ctx, binding)
+	return found && service.Spec.ServiceClassType == "CF"
+}
+
+// getService returns the bound Service, or false if it can't be found
+func (v *BindingValidator) getService(ctx context.Context, binding *ibmcloudv1.Binding) (*ibmcloudv1.Service, bool) {
+	if binding.Spec.ServiceName == "" {
+		return nil, false
+	}
+	namespace := binding.Namespace
+	if binding.Spec.ServiceNamespace != "" {
+		namespace = binding.Spec.ServiceNamespace
+	}
+	var service ibmcloudv1.Service
+	err := v.Client.Get(ctx, types.NamespacedName{Name: binding.Spec.ServiceName, Namespace: namespace}, &service)
+	return &service, err == nil
+}
+
+// validateSecretTemplate checks each key is a valid secret key and each value is a valid template
+func validateSecretTemplate(path *field.Path, templates map[string]string) field.ErrorList {
+	keys := make([]string, 0, len(templates))
+	for key := range templates {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var allErrs field.ErrorList
+	for _, key := range keys {
+		text := templates[key]
+		for _, msg := range validation.IsConfigMapKey(key) {
+			allErrs = append(allErrs, field.Invalid(path, key, msg))
+		}
+		if _, err := secrettemplate.Parse(key, text); err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Key(key), text, err.Error()))
+		}
+	}
+	return allErrs
+}
+
+// validateCertificateJSONPaths checks each certificate has a valid JSONPath
+func validateCertificateJSONPaths(path *field.Path, bindingCertificates []ibmcloudv1.BindingCertificate) field.ErrorList {
+	var allErrs field.ErrorList
+	for i, certificate := range bindingCertificates {
+		if _, err := certificates.Parse(certificate.Key, certificate.JSONPath); err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Index(i).Child("jsonPath"), certificate.JSONPath, err.Error()))
+		}
+	}
+	return allErrs
+}
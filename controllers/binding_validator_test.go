@@ -0,0 +1,140 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	ibmcloudv1 "github.com/ibm/cloud-operators/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+func newTestBindingValidator(t *testing.T, objs ...runtime.Object) *BindingValidator {
+	t.Helper()
+	scheme := schemas(t)
+	decoder, err := admission.NewDecoder(scheme)
+	require.NoError(t, err)
+	v := &BindingValidator{Client: fake.NewFakeClientWithScheme(scheme, objs...)}
+	require.NoError(t, v.InjectDecoder(decoder))
+	return v
+}
+
+func bindingAdmissionRequest(t *testing.T, operation admissionv1beta1.Operation, binding *ibmcloudv1.Binding) admission.Request {
+	t.Helper()
+	binding.TypeMeta = metav1.TypeMeta{Kind: "Binding", APIVersion: "ibmcloud.ibm.com/v1"}
+	raw, err := json.Marshal(binding)
+	require.NoError(t, err)
+	return admission.Request{
+		AdmissionRequest: admissionv1beta1.AdmissionRequest{
+			Namespace: "mynamespace",
+			Operation: operation,
+			Object:    runtime.RawExtension{Raw: raw},
+		},
+	}
+}
+
+func TestBindingValidator(t *testing.T) {
+	t.Parallel()
+	v := newTestBindingValidator(t,
+		&ibmcloudv1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "myservice", Namespace: "mynamespace"},
+			Spec:       ibmcloudv1.ServiceSpec{ServiceClass: "myclass", Plan: "Lite"},
+		},
+		&ibmcloudv1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "mycfservice", Namespace: "mynamespace"},
+			Spec:       ibmcloudv1.ServiceSpec{ServiceClass: "myclass", Plan: "Lite", ServiceClassType: "CF"},
+		},
+	)
+
+	for _, tc := range []struct {
+		description string
+		spec        ibmcloudv1.BindingSpec
+		annotations map[string]string
+		expectErr   string
+	}{
+		{
+			description: "alias with key ID",
+			spec:        ibmcloudv1.BindingSpec{ServiceName: "myservice", Alias: "mycredentials"},
+			annotations: map[string]string{ibmcloudv1.KeyIDAnnotation: "mykey"},
+		},
+		{
+			description: "alias without key ID",
+			spec:        ibmcloudv1.BindingSpec{ServiceName: "myservice", Alias: "mycredentials"},
+			expectErr:   `Binding.ibmcloud.ibm.com "mybinding" is invalid: metadata.annotations[ibmcloud.ibm.com/keyId]: Required value: alias credentials for non-CF services require the credentials' key ID`,
+		},
+		{
+			description: "CF alias without key ID",
+			spec:        ibmcloudv1.BindingSpec{ServiceName: "mycfservice", Alias: "mycredentials"},
+		},
+		{
+			description: "rotation",
+			spec:        ibmcloudv1.BindingSpec{ServiceName: "myservice", Rotation: &ibmcloudv1.BindingRotation{Interval: metav1.Duration{Duration: time.Hour}}},
+		},
+		{
+			description: "CF rotation",
+			spec:        ibmcloudv1.BindingSpec{ServiceName: "mycfservice", Rotation: &ibmcloudv1.BindingRotation{Interval: metav1.Duration{Duration: time.Hour}}},
+			expectErr:   `Binding.ibmcloud.ibm.com "mybinding" is invalid: spec.rotation: Forbidden: rotation is not supported for CF services`,
+		},
+		{
+			description: "secret template",
+			spec:        ibmcloudv1.BindingSpec{ServiceName: "myservice", SecretTemplate: map[string]string{"DATABASE_URL": "{{ index .connection.postgres.composed 0 }}"}},
+		},
+		{
+			description: "invalid secret template",
+			spec:        ibmcloudv1.BindingSpec{ServiceName: "myservice", SecretTemplate: map[string]string{"bad key": "{{ .apikey"}},
+			expectErr:   `Binding.ibmcloud.ibm.com "mybinding" is invalid: [spec.secretTemplate: Invalid value: "bad key": a valid config key must consist of alphanumeric characters, '-', '_' or '.' (e.g. 'key.name',  or 'KEY_NAME',  or 'key-name', regex used for validation is '[-._a-zA-Z0-9]+'), spec.secretTemplate[bad key]: Invalid value: "{{ .apikey": template: bad key:1: unclosed action]`,
+		},
+		{
+			description: "instance CRN alias without key ID",
+			spec:        ibmcloudv1.BindingSpec{InstanceCRN: "crn:v1:bluemix:public:cloud-object-storage:global:a/myaccount:myinstance::", Alias: "mycredentials"},
+			expectErr:   `Binding.ibmcloud.ibm.com "mybinding" is invalid: metadata.annotations[ibmcloud.ibm.com/keyId]: Required value: alias credentials for non-CF services require the credentials' key ID`,
+		},
+		{
+			description: "alias without key ID for missing service",
+			spec:        ibmcloudv1.BindingSpec{ServiceName: "myservice", ServiceNamespace: "othernamespace", Alias: "mycredentials"},
+		},
+		{
+			description: "invalid certificate JSONPath",
+			spec: ibmcloudv1.BindingSpec{ServiceName: "myservice", Certificates: []ibmcloudv1.BindingCertificate{
+				{Key: "ca.crt", JSONPath: "{.certificate[}"},
+			}},
+			expectErr: `Binding.ibmcloud.ibm.com "mybinding" is invalid: spec.certificates[0].jsonPath: Invalid value: "{.certificate[}": unterminated array`,
+		},
+		{
+			description: "invalid spec",
+			expectErr:   `Binding.ibmcloud.ibm.com "mybinding" is invalid: spec.serviceName: Required value: the name of the Service to bind is required`,
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			binding := &ibmcloudv1.Binding{
+				ObjectMeta: metav1.ObjectMeta{Name: "mybinding", Annotations: tc.annotations},
+				Spec:       tc.spec,
+			}
+			resp := v.Handle(context.Background(), bindingAdmissionRequest(t, admissionv1beta1.Create, binding))
+			if tc.expectErr != "" {
+				assert.False(t, resp.Allowed)
+				assert.Equal(t, tc.expectErr, string(resp.Result.Reason))
+				return
+			}
+			assert.True(t, resp.Allowed)
+		})
+	}
+}
+
+func TestBindingValidatorUpdateWhileDeleting(t *testing.T) {
+	t.Parallel()
+	v := newTestBindingValidator(t)
+	now := metav1.Now()
+	binding := &ibmcloudv1.Binding{ObjectMeta: metav1.ObjectMeta{Name: "mybinding"}}
+	assert.False(t, v.Handle(context.Background(), bindingAdmissionRequest(t, admissionv1beta1.Update, binding.DeepCopy())).Allowed)
+
+	binding.DeletionTimestamp = &now
+	assert.True(t, v.Handle(context.Background(), bindingAdmissionRequest(t, admissionv1beta1.Update, binding)).Allowed)
+}
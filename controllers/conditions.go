@@ -0,0 +1,118 @@
+package controllers
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	ibmcloudv1 "github.com/ibm/cloud-operators/api/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// now returns the time used for condition transitions. Tests replace it to get stable timestamps.
+var now = metav1.Now
+
+func newCondition(conditionType string, isTrue bool, reason, message string, generation int64) ibmcloudv1.Condition {
+	status := corev1.ConditionFalse
+	if isTrue {
+		status = corev1.ConditionTrue
+	}
+	return ibmcloudv1.Condition{
+		Type:               conditionType,
+		Status:             status,
+		ObservedGeneration: generation,
+		LastTransitionTime: now(),
+		Reason:             reason,
+		Message:            message,
+	}
+}
+
+// conditionReason converts a state like "Online" or "in progress" into a CamelCase condition reason
+func conditionReason(state string) string {
+	words := strings.FieldsFunc(state, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if len(words) == 0 {
+		return "Unknown"
+	}
+	var reason strings.Builder
+	for _, word := range words {
+		reason.WriteString(strings.ToUpper(word[:1]))
+		reason.WriteString(word[1:])
+	}
+	return reason.String()
+}
+
+func isServiceProvisioned(instance *ibmcloudv1.Service) bool {
+	return instance.Status.InstanceID != "" && instance.Status.InstanceID != inProgress
+}
+
+// setServiceConditions derives the standard conditions and observed generation from the Service's current status.
+// paramsHash is the hash of the resolved parameters, which keeps ParametersApplied false while a changed value is not yet applied.
+// Cloud Foundry service instances are never updated, so their changed values are reported as not supported instead.
+func setServiceConditions(instance *ibmcloudv1.Service, paramsHash string) {
+	status := &instance.Status
+	generation := instance.Generation
+	status.ObservedGeneration = generation
+
+	reason := conditionReason(status.State)
+	online := status.State == serviceStateOnline
+	ibmcloudv1.SetCondition(&status.Conditions, newCondition(ibmcloudv1.ConditionReady, online, reason, status.Message, generation))
+
+	switch {
+	case isServiceProvisioned(instance):
+		ibmcloudv1.SetCondition(&status.Conditions, newCondition(ibmcloudv1.ConditionProvisioned, true, "Provisioned", "", generation))
+		if isObserved(instance) {
+			ibmcloudv1.SetCondition(&status.Conditions, newCondition(ibmcloudv1.ConditionParametersApplied, false, "Observed", "Parameters and tags are not applied to observed service instances", generation))
+		} else if instance.Spec.ServiceClassType == "CF" && parametersHashChanged(instance, paramsHash) {
+			ibmcloudv1.SetCondition(&status.Conditions, newCondition(ibmcloudv1.ConditionParametersApplied, false, "NotSupported", "Changed parameter values are not applied to Cloud Foundry service instances", generation))
+		} else if tagsOrParamsChanged(instance) || parametersHashChanged(instance, paramsHash) {
+			ibmcloudv1.SetCondition(&status.Conditions, newCondition(ibmcloudv1.ConditionParametersApplied, false, reason, status.Message, generation))
+		} else {
+			ibmcloudv1.SetCondition(&status.Conditions, newCondition(ibmcloudv1.ConditionParametersApplied, true, "Applied", "", generation))
+		}
+	case status.InstanceID == inProgress:
+		ibmcloudv1.SetCondition(&status.Conditions, newCondition(ibmcloudv1.ConditionProvisioned, false, "Provisioning", "", generation))
+		ibmcloudv1.SetCondition(&status.Conditions, newCondition(ibmcloudv1.ConditionParametersApplied, false, "NotProvisioned", "", generation))
+	default:
+		ibmcloudv1.SetCondition(&status.Conditions, newCondition(ibmcloudv1.ConditionProvisioned, false, reason, status.Message, generation))
+		ibmcloudv1.SetCondition(&status.Conditions, newCondition(ibmcloudv1.ConditionParametersApplied, false, "NotProvisioned", "", generation))
+	}
+
+	switch {
+	case planChanged(instance):
+		if status.State == serviceStateFailed {
+			ibmcloudv1.SetCondition(&status.Conditions, newCondition(ibmcloudv1.ConditionPlanUpdated, false, reason, status.Message, generation))
+		} else {
+			message := fmt.Sprintf("Changing plan from %s to %s", status.Plan, instance.Spec.Plan)
+			ibmcloudv1.SetCondition(&status.Conditions, newCondition(ibmcloudv1.ConditionPlanUpdated, false, "Updating", message, generation))
+		}
+	case ibmcloudv1.FindCondition(status.Conditions, ibmcloudv1.ConditionPlanUpdated) != nil:
+		ibmcloudv1.SetCondition(&status.Conditions, newCondition(ibmcloudv1.ConditionPlanUpdated, true, "Updated", "", generation))
+	}
+
+	if !instance.DeletionTimestamp.IsZero() {
+		ibmcloudv1.SetCondition(&status.Conditions, newCondition(ibmcloudv1.ConditionDeleting, true, "Deleting", "", generation))
+	}
+}
+
+// setBindingConditions derives the standard conditions and observed generation from the Binding's current status
+func setBindingConditions(instance *ibmcloudv1.Binding) {
+	status := &instance.Status
+	generation := instance.Generation
+	status.ObservedGeneration = generation
+
+	reason := conditionReason(status.State)
+	online := status.State == bindingStateOnline
+	ibmcloudv1.SetCondition(&status.Conditions, newCondition(ibmcloudv1.ConditionReady, online, reason, status.Message, generation))
+	if online {
+		ibmcloudv1.SetCondition(&status.Conditions, newCondition(ibmcloudv1.ConditionCredentialsSynced, true, "Synced", "", generation))
+	} else {
+		ibmcloudv1.SetCondition(&status.Conditions, newCondition(ibmcloudv1.ConditionCredentialsSynced, false, reason, status.Message, generation))
+	}
+
+	if !instance.DeletionTimestamp.IsZero() {
+		ibmcloudv1.SetCondition(&status.Conditions, newCondition(ibmcloudv1.ConditionDeleting, true, "Deleting", "", generation))
+	}
+}
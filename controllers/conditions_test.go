@@ -0,0 +1,149 @@
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	ibmcloudv1 "github.com/ibm/cloud-operators/api/v1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestConditionReason(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		state  string
+		expect string
+	}{
+		{state: "", expect: "Unknown"},
+		{state: serviceStateOnline, expect: "Online"},
+		{state: "in progress", expect: "InProgress"},
+		{state: "provisioning-failed", expect: "ProvisioningFailed"},
+	} {
+		t.Run(tc.state, func(t *testing.T) {
+			assert.Equal(t, tc.expect, conditionReason(tc.state))
+		})
+	}
+}
+
+func TestSetServiceConditions(t *testing.T) {
+	t.Parallel()
+	t.Run("online", func(t *testing.T) {
+		service := &ibmcloudv1.Service{
+			ObjectMeta: metav1.ObjectMeta{Generation: 2},
+			Status: ibmcloudv1.ServiceStatus{
+				State:      serviceStateOnline,
+				InstanceID: "myinstance",
+			},
+		}
+		setServiceConditions(service, service.Status.ParametersHash)
+		assert.Equal(t, int64(2), service.Status.ObservedGeneration)
+		assert.Equal(t, []ibmcloudv1.Condition{
+			{Type: ibmcloudv1.ConditionReady, Status: corev1.ConditionTrue, ObservedGeneration: 2, LastTransitionTime: testConditionTime, Reason: "Online"},
+			{Type: ibmcloudv1.ConditionProvisioned, Status: corev1.ConditionTrue, ObservedGeneration: 2, LastTransitionTime: testConditionTime, Reason: "Provisioned"},
+			{Type: ibmcloudv1.ConditionParametersApplied, Status: corev1.ConditionTrue, ObservedGeneration: 2, LastTransitionTime: testConditionTime, Reason: "Applied"},
+		}, service.Status.Conditions)
+	})
+
+	t.Run("provisioning in progress", func(t *testing.T) {
+		service := &ibmcloudv1.Service{
+			Status: ibmcloudv1.ServiceStatus{
+				State:      serviceStatePending,
+				Message:    "Processing Resource",
+				InstanceID: inProgress,
+			},
+		}
+		setServiceConditions(service, service.Status.ParametersHash)
+		assert.Equal(t, []ibmcloudv1.Condition{
+			{Type: ibmcloudv1.ConditionReady, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime, Reason: "Pending", Message: "Processing Resource"},
+			{Type: ibmcloudv1.ConditionProvisioned, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime, Reason: "Provisioning"},
+			{Type: ibmcloudv1.ConditionParametersApplied, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime, Reason: "NotProvisioned"},
+		}, service.Status.Conditions)
+	})
+
+	t.Run("keeps transition time when status is unchanged", func(t *testing.T) {
+		earlier := metav1.NewTime(testConditionTime.Add(-time.Hour))
+		service := &ibmcloudv1.Service{
+			Status: ibmcloudv1.ServiceStatus{
+				State:      serviceStateOnline,
+				InstanceID: "myinstance",
+				Conditions: []ibmcloudv1.Condition{
+					{Type: ibmcloudv1.ConditionReady, Status: corev1.ConditionTrue, LastTransitionTime: earlier, Reason: "Online"},
+					{Type: ibmcloudv1.ConditionProvisioned, Status: corev1.ConditionFalse, LastTransitionTime: earlier, Reason: "Provisioning"},
+				},
+			},
+		}
+		setServiceConditions(service, service.Status.ParametersHash)
+		assert.Equal(t, earlier, ibmcloudv1.FindCondition(service.Status.Conditions, ibmcloudv1.ConditionReady).LastTransitionTime)
+		assert.Equal(t, testConditionTime, ibmcloudv1.FindCondition(service.Status.Conditions, ibmcloudv1.ConditionProvisioned).LastTransitionTime)
+	})
+
+	t.Run("parameter change pending", func(t *testing.T) {
+		service := &ibmcloudv1.Service{
+			Status: ibmcloudv1.ServiceStatus{State: serviceStateOnline, InstanceID: "myinstance", ParametersHash: "oldhash"},
+		}
+		setServiceConditions(service, "newhash")
+		assert.False(t, ibmcloudv1.IsConditionTrue(service.Status.Conditions, ibmcloudv1.ConditionParametersApplied))
+
+		service.Status.ParametersHash = "newhash"
+		setServiceConditions(service, "newhash")
+		assert.True(t, ibmcloudv1.IsConditionTrue(service.Status.Conditions, ibmcloudv1.ConditionParametersApplied))
+	})
+
+	t.Run("parameter change on CF service", func(t *testing.T) {
+		service := &ibmcloudv1.Service{
+			Spec:   ibmcloudv1.ServiceSpec{ServiceClassType: "CF"},
+			Status: ibmcloudv1.ServiceStatus{State: serviceStateOnline, InstanceID: "myinstance", ParametersHash: "oldhash"},
+		}
+		setServiceConditions(service, "newhash")
+		assert.Equal(t, &ibmcloudv1.Condition{
+			Type: ibmcloudv1.ConditionParametersApplied, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime,
+			Reason: "NotSupported", Message: "Changed parameter values are not applied to Cloud Foundry service instances",
+		}, ibmcloudv1.FindCondition(service.Status.Conditions, ibmcloudv1.ConditionParametersApplied))
+	})
+
+	t.Run("plan change pending", func(t *testing.T) {
+		service := &ibmcloudv1.Service{
+			Spec:   ibmcloudv1.ServiceSpec{Plan: "standard"},
+			Status: ibmcloudv1.ServiceStatus{State: serviceStateOnline, Plan: "Lite", InstanceID: "myinstance"},
+		}
+		setServiceConditions(service, service.Status.ParametersHash)
+		assert.Equal(t, &ibmcloudv1.Condition{
+			Type: ibmcloudv1.ConditionPlanUpdated, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime, Reason: "Updating", Message: "Changing plan from Lite to standard",
+		}, ibmcloudv1.FindCondition(service.Status.Conditions, ibmcloudv1.ConditionPlanUpdated))
+
+		service.Status.Plan = "standard"
+		setServiceConditions(service, service.Status.ParametersHash)
+		assert.True(t, ibmcloudv1.IsConditionTrue(service.Status.Conditions, ibmcloudv1.ConditionPlanUpdated))
+	})
+
+	t.Run("deleting", func(t *testing.T) {
+		deletionTime := metav1.Now()
+		service := &ibmcloudv1.Service{
+			ObjectMeta: metav1.ObjectMeta{DeletionTimestamp: &deletionTime},
+			Status:     ibmcloudv1.ServiceStatus{State: serviceStateOnline, InstanceID: "myinstance"},
+		}
+		setServiceConditions(service, service.Status.ParametersHash)
+		assert.True(t, ibmcloudv1.IsConditionTrue(service.Status.Conditions, ibmcloudv1.ConditionDeleting))
+	})
+}
+
+func TestSetBindingConditions(t *testing.T) {
+	t.Parallel()
+	binding := &ibmcloudv1.Binding{
+		ObjectMeta: metav1.ObjectMeta{Generation: 1},
+		Status:     ibmcloudv1.BindingStatus{State: bindingStateFailed, Message: "failed"},
+	}
+	setBindingConditions(binding)
+	assert.Equal(t, []ibmcloudv1.Condition{
+		{Type: ibmcloudv1.ConditionReady, Status: corev1.ConditionFalse, ObservedGeneration: 1, LastTransitionTime: testConditionTime, Reason: "Failed", Message: "failed"},
+		{Type: ibmcloudv1.ConditionCredentialsSynced, Status: corev1.ConditionFalse, ObservedGeneration: 1, LastTransitionTime: testConditionTime, Reason: "Failed", Message: "failed"},
+	}, binding.Status.Conditions)
+
+	binding.Status.State = bindingStateOnline
+	binding.Status.Message = bindingStateOnline
+	setBindingConditions(binding)
+	assert.True(t, ibmcloudv1.IsConditionTrue(binding.Status.Conditions, ibmcloudv1.ConditionReady))
+	assert.True(t, ibmcloudv1.IsConditionTrue(binding.Status.Conditions, ibmcloudv1.ConditionCredentialsSynced))
+}
@@ -16,8 +16,8 @@ func getConfigMap(ctx context.Context, r client.Client, logt logr.Logger, cmname
 
 	var cm v1.ConfigMap
 	if err := r.Get(ctx, client.ObjectKey{Namespace: namespace, Name: cmname}, &cm); err != nil {
-		if namespace != "default" && fallback {
-			if err := r.Get(ctx, client.ObjectKey{Namespace: "default", Name: cmname}, &cm); err != nil {
+		if namespace != fallbackNamespace && fallback {
+			if err := r.Get(ctx, client.ObjectKey{Namespace: fallbackNamespace, Name: cmname}, &cm); err != nil {
 				log.V(5).Info("configmap not found")
 				return nil, err
 			}
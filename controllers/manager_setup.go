@@ -3,6 +3,7 @@ package controllers
 import (
 	"net/http"
 
+	ibmcloudv1 "github.com/ibm/cloud-operators/api/v1"
 	"github.com/ibm/cloud-operators/internal/config"
 	"github.com/ibm/cloud-operators/internal/ibmcloud"
 	"github.com/ibm/cloud-operators/internal/ibmcloud/auth"
@@ -13,6 +14,7 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
 )
 
 // Controllers passes back references to set up controllers for test mocking purposes
@@ -41,6 +43,36 @@ func setUpControllers(mgr ctrl.Manager, setup controllerSetUpFunc) (*Controllers
 	return c, errors.Wrap(err, "Unable to setup controller")
 }
 
+// SetUpWebhooks registers the conversion, validating, defaulting and pod injection webhooks with the manager's webhook server
+func SetUpWebhooks(mgr ctrl.Manager) error {
+	if err := (&ibmcloudv1.Service{}).SetupWebhookWithManager(mgr); err != nil {
+		return errors.Wrap(err, "Unable to setup Service webhook")
+	}
+	if err := (&ibmcloudv1.Binding{}).SetupWebhookWithManager(mgr); err != nil {
+		return errors.Wrap(err, "Unable to setup Binding webhook")
+	}
+	mgr.GetWebhookServer().Register(bindingValidatorPath, &webhook.Admission{
+		Handler: &BindingValidator{
+			Client: mgr.GetClient(),
+		},
+	})
+	mgr.GetWebhookServer().Register(serviceDefaulterPath, &webhook.Admission{
+		Handler: &ServiceDefaulter{
+			Client:            mgr.GetClient(),
+			Log:               ctrl.Log.WithName("webhooks").WithName("Service"),
+			StrictNamespaces:  config.Get().StrictNamespaces,
+			GetDefaultContext: ibmcloud.GetDefaultContext,
+		},
+	})
+	mgr.GetWebhookServer().Register(bindingInjectorPath, &webhook.Admission{
+		Handler: &BindingInjector{
+			Client: mgr.GetClient(),
+			Log:    ctrl.Log.WithName("webhooks").WithName("Pod"),
+		},
+	})
+	return nil
+}
+
 type controllerSetUpFunc func(err *error, r reconciler, mgr ctrl.Manager, options controller.Options)
 
 type reconciler interface {
@@ -55,11 +87,20 @@ func setupWithManagerOrErr(err *error, r reconciler, mgr ctrl.Manager, options c
 }
 
 func setUpControllerDependencies(mgr ctrl.Manager) *Controllers {
+	hashKey := newHashKey(mgr.GetAPIReader(), mgr.GetClient(), config.Get().ControllerNamespace)
+	paramSources := &ParamSources{}
 	return &Controllers{
 		BindingReconciler: &BindingReconciler{
-			Client: mgr.GetClient(),
-			Log:    ctrl.Log.WithName("controllers").WithName("Binding"),
-			Scheme: mgr.GetScheme(),
+			Client:    mgr.GetClient(),
+			Log:       ctrl.Log.WithName("controllers").WithName("Binding"),
+			Scheme:    mgr.GetScheme(),
+			Recorder:  mgr.GetEventRecorderFor("binding-controller"),
+			APIReader: mgr.GetAPIReader(),
+
+			StrictNamespaces:      config.Get().StrictNamespaces,
+			InstanceCRNNamespaces: config.Get().InstanceCRNNamespaces,
+			HashKey:               hashKey,
+			ParamSources:          paramSources,
 
 			CreateCFServiceKey:         cfservice.CreateKey,
 			CreateResourceServiceKey:   resource.CreateKey,
@@ -79,6 +120,10 @@ func setUpControllerDependencies(mgr ctrl.Manager) *Controllers {
 			Log:    ctrl.Log.WithName("controllers").WithName("Service"),
 			Scheme: mgr.GetScheme(),
 
+			StrictNamespaces: config.Get().StrictNamespaces,
+			HashKey:          hashKey,
+			ParamSources:     paramSources,
+
 			CreateCFServiceInstance:         cfservice.CreateInstance,
 			CreateResourceServiceInstance:   resource.CreateServiceInstance,
 			DeleteCFServiceInstance:         cfservice.DeleteInstance,
@@ -86,6 +131,7 @@ func setUpControllerDependencies(mgr ctrl.Manager) *Controllers {
 			GetCFServiceInstance:            cfservice.GetInstance,
 			GetIBMCloudInfo:                 ibmcloud.GetInfo,
 			GetResourceServiceAliasInstance: resource.GetServiceAliasInstance,
+			GetResourceServiceInstance:      resource.GetServiceInstance,
 			GetResourceServiceInstanceState: resource.GetServiceInstanceState,
 			UpdateResourceServiceInstance:   resource.UpdateServiceInstance,
 		},
@@ -149,6 +149,10 @@ func assertNoNilFieldsReflect(t *testing.T, v reflect.Value, name string) {
 				assertNoNilFieldsReflect(t, field, joinFields(name, typeField.Name))
 			}
 		}
+	case reflect.Bool:
+		// false is a valid setting, e.g. for StrictNamespaces
+	case reflect.Slice:
+		// an empty list is a valid setting, e.g. for InstanceCRNNamespaces
 	default:
 		if v.IsZero() {
 			t.Errorf("Field %q is not set up properly for controllers", name)
@@ -172,12 +176,16 @@ func (m *mockManager) GetClient() client.Client {
 	return fake.NewFakeClient()
 }
 
+func (m *mockManager) GetAPIReader() client.Reader {
+	return fake.NewFakeClient()
+}
+
 func (m *mockManager) GetScheme() *runtime.Scheme {
 	return schemas(m.T)
 }
 
 func (m *mockManager) GetConfig() *rest.Config {
-	return nil
+	return &rest.Config{}
 }
 
 func (m *mockManager) SetFields(interface{}) error {
@@ -189,9 +197,12 @@ func (m *mockManager) GetCache() cache.Cache {
 }
 
 func (m *mockManager) GetEventRecorderFor(string) record.EventRecorder {
-	return nil
+	return &record.FakeRecorder{}
 }
 
 func (m *mockManager) Add(c manager.Runnable) error {
-	return c.(inject.Injector).InjectFunc(m.SetFields)
+	if injector, ok := c.(inject.Injector); ok {
+		return injector.InjectFunc(m.SetFields)
+	}
+	return nil
 }
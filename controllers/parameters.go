@@ -0,0 +1,230 @@
+package controllers
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	ibmcloudv1 "github.com/ibm/cloud-operators/api/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// hashKeySecretName is the secret in the controller namespace holding the key for parameter and secret hashes
+	hashKeySecretName = "ibmcloud-operator-parameters-key"
+	hashKeySecretKey  = "key"
+	hashKeyLength     = 32
+)
+
+// hashKeySecretLabels mark the hash key secret as created by the operator
+var hashKeySecretLabels = map[string]string{
+	"app.kubernetes.io/name":       "ibmcloud-operator",
+	"app.kubernetes.io/component":  "parameters-key",
+	"app.kubernetes.io/managed-by": "ibmcloud-operator",
+}
+
+// HashKey is the operator's key for the hashes recorded in statuses and annotations, which keeps them from being used to guess values offline.
+// The key is loaded on first use from a secret in the controller namespace, which is created with a random key if missing.
+type HashKey struct {
+	Reader    client.Reader
+	Writer    client.Writer
+	Namespace string
+
+	mu  sync.Mutex
+	key []byte
+}
+
+func newHashKey(reader client.Reader, writer client.Writer, namespace string) *HashKey {
+	if namespace == "" {
+		namespace = fallbackNamespace
+	}
+	return &HashKey{Reader: reader, Writer: writer, Namespace: namespace}
+}
+
+// Get returns the key, loading or creating its secret on first use. A nil HashKey has an empty key.
+func (k *HashKey) Get(ctx context.Context) ([]byte, error) {
+	if k == nil {
+		return nil, nil
+	}
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if k.key == nil {
+		key, err := loadHashKey(ctx, k.Reader, k.Writer, k.Namespace)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load hash key: %w", err)
+		}
+		k.key = key
+	}
+	return k.key, nil
+}
+
+// parametersHash returns an HMAC-SHA-256 of the resolved parameters, which changes whenever a value read from a secret or configmap does.
+// Returns an empty hash if there are no parameters, without loading the key.
+func parametersHash(ctx context.Context, hashKey *HashKey, params map[string]interface{}) (string, error) {
+	if len(params) == 0 {
+		return "", nil
+	}
+	data, err := json.Marshal(params) // map keys are sorted, so the hash is independent of parameter order
+	if err != nil {
+		return "", err
+	}
+	key, err := hashKey.Get(ctx)
+	if err != nil {
+		return "", err
+	}
+	mac := hmac.New(sha256.New, key)
+	_, _ = mac.Write(data)
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+// loadHashKey returns the key stored in the hash key secret, creating the secret with a random key if it does not exist
+func loadHashKey(ctx context.Context, reader client.Reader, writer client.Writer, namespace string) ([]byte, error) {
+	name := types.NamespacedName{Namespace: namespace, Name: hashKeySecretName}
+	secret := &corev1.Secret{}
+	err := reader.Get(ctx, name, secret)
+	if errors.IsNotFound(err) {
+		key := make([]byte, hashKeyLength)
+		if _, err := rand.Read(key); err != nil {
+			return nil, err
+		}
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: name.Name, Namespace: name.Namespace, Labels: hashKeySecretLabels},
+			Data:       map[string][]byte{hashKeySecretKey: key},
+		}
+		err = writer.Create(ctx, secret)
+		if errors.IsAlreadyExists(err) { // another replica created it first
+			err = reader.Get(ctx, name, secret)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	key := secret.Data[hashKeySecretKey]
+	if len(key) == 0 {
+		return nil, fmt.Errorf("secret %s in namespace %s has no %q key", name.Name, name.Namespace, hashKeySecretKey)
+	}
+	return key, nil
+}
+
+// paramNames returns the names of the parameters, or nil if there are none
+func paramNames(params []ibmcloudv1.Param) []string {
+	var names []string
+	for _, p := range params {
+		names = append(names, p.Name)
+	}
+	return names
+}
+
+// migrateStatusParameters replaces the parameters copied into the status by earlier versions, which may hold sensitive values,
+// with their names. Returns true if the status changed.
+// Earlier versions never re-applied changed secret and configmap values, so the hash is left empty and the parameters are re-applied once.
+func migrateStatusParameters(instance *ibmcloudv1.Service) bool {
+	if instance.Status.Parameters == nil {
+		return false
+	}
+	instance.Status.ParameterNames = paramNames(instance.Status.Parameters)
+	instance.Status.ParametersHash = ""
+	instance.Status.Parameters = nil
+	return true
+}
+
+// paramSourceKind is the kind of object a parameter reads its value from
+type paramSourceKind string
+
+const (
+	secretParamSource    paramSourceKind = "Secret"
+	configMapParamSource paramSourceKind = "ConfigMap"
+)
+
+// paramsReference returns true if any of the parameters reads its value from the secret or configmap with the given name
+func paramsReference(params []ibmcloudv1.Param, kind paramSourceKind, name string) bool {
+	for _, p := range params {
+		if p.ValueFrom == nil {
+			continue
+		}
+		switch kind {
+		case secretParamSource:
+			if p.ValueFrom.SecretKeyRef != nil && p.ValueFrom.SecretKeyRef.Name == name {
+				return true
+			}
+		case configMapParamSource:
+			if p.ValueFrom.ConfigMapKeyRef != nil && p.ValueFrom.ConfigMapKeyRef.Name == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// bindingParamsReference returns true if the parameters of the Binding or of any of its additional credentials
+// read their value from the secret or configmap with the given name
+func bindingParamsReference(binding *ibmcloudv1.Binding, kind paramSourceKind, name string) bool {
+	if paramsReference(binding.Spec.Parameters, kind, name) {
+		return true
+	}
+	for _, credentials := range binding.Spec.Credentials {
+		if paramsReference(credentials.Parameters, kind, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// paramSourceListOptions returns the namespaces of resources which may read parameters from the given secret or configmap.
+// Unless strict namespaces are enabled, resources in any namespace may fall back to the default namespace.
+func paramSourceListOptions(obj handler.MapObject, strictNamespaces bool) []client.ListOption {
+	namespace := obj.Meta.GetNamespace()
+	if namespace == fallbackNamespace && !strictNamespaces {
+		return nil
+	}
+	return []client.ListOption{client.InNamespace(namespace)}
+}
+
+// requestsForParamSource returns a function mapping a secret or configmap of the given kind to the Services with parameters read from it
+func (r *ServiceReconciler) requestsForParamSource(kind paramSourceKind) handler.ToRequestsFunc {
+	return func(obj handler.MapObject) []reconcile.Request {
+		var services ibmcloudv1.ServiceList
+		if err := r.List(context.Background(), &services, paramSourceListOptions(obj, r.StrictNamespaces)...); err != nil {
+			r.Log.Error(err, "Failed to list services referencing parameter source", "namespace", obj.Meta.GetNamespace(), "name", obj.Meta.GetName())
+			return nil
+		}
+		var requests []reconcile.Request
+		for _, service := range services.Items {
+			if paramsReference(service.Spec.Parameters, kind, obj.Meta.GetName()) {
+				requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: service.Namespace, Name: service.Name}})
+			}
+		}
+		return requests
+	}
+}
+
+// requestsForParamSource returns a function mapping a secret or configmap of the given kind to the Bindings with parameters read from it,
+// including the parameters of their additional credentials
+func (r *BindingReconciler) requestsForParamSource(kind paramSourceKind) handler.ToRequestsFunc {
+	return func(obj handler.MapObject) []reconcile.Request {
+		var bindings ibmcloudv1.BindingList
+		if err := r.List(context.Background(), &bindings, paramSourceListOptions(obj, r.StrictNamespaces)...); err != nil {
+			r.Log.Error(err, "Failed to list bindings referencing parameter source", "namespace", obj.Meta.GetNamespace(), "name", obj.Meta.GetName())
+			return nil
+		}
+		var requests []reconcile.Request
+		for i := range bindings.Items {
+			binding := &bindings.Items[i]
+			if bindingParamsReference(binding, kind, obj.Meta.GetName()) {
+				requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: binding.Namespace, Name: binding.Name}})
+			}
+		}
+		return requests
+	}
+}
@@ -0,0 +1,156 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	ibmcloudv1 "github.com/ibm/cloud-operators/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestParametersHash(t *testing.T) {
+	t.Parallel()
+	emptyHash, err := parametersHash(context.Background(), nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "", emptyHash)
+
+	hash, err := parametersHash(context.Background(), nil, map[string]interface{}{"a": json.Number("1"), "b": "password"})
+	require.NoError(t, err)
+	assert.Len(t, hash, 64)
+
+	sameHash, err := parametersHash(context.Background(), nil, map[string]interface{}{"b": "password", "a": json.Number("1")})
+	require.NoError(t, err)
+	assert.Equal(t, hash, sameHash, "Hash should not depend on parameter order")
+
+	changedHash, err := parametersHash(context.Background(), nil, map[string]interface{}{"a": json.Number("1"), "b": "new password"})
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, changedHash)
+
+	keyedHash, err := parametersHash(context.Background(), &HashKey{key: []byte("key")}, map[string]interface{}{"a": json.Number("1"), "b": "password"})
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, keyedHash, "Hash should depend on the operator's key")
+}
+
+func TestHashKey(t *testing.T) {
+	t.Parallel()
+	scheme := schemas(t)
+	client := fake.NewFakeClientWithScheme(scheme)
+
+	hashKey := newHashKey(client, client, "mynamespace")
+	key, err := hashKey.Get(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, key, hashKeyLength)
+
+	var secret corev1.Secret
+	require.NoError(t, client.Get(context.Background(), types.NamespacedName{Name: hashKeySecretName, Namespace: "mynamespace"}, &secret))
+	assert.Equal(t, hashKeySecretLabels, secret.Labels)
+	assert.Equal(t, key, secret.Data[hashKeySecretKey])
+
+	sameKey, err := newHashKey(client, client, "mynamespace").Get(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, key, sameKey, "Key should be read from the secret once created")
+
+	emptyClient := fake.NewFakeClientWithScheme(scheme, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: hashKeySecretName, Namespace: "mynamespace"},
+	})
+	_, err = newHashKey(emptyClient, emptyClient, "mynamespace").Get(context.Background())
+	assert.EqualError(t, err, `failed to load hash key: secret ibmcloud-operator-parameters-key in namespace mynamespace has no "key" key`)
+
+	key, err = (*HashKey)(nil).Get(context.Background())
+	assert.NoError(t, err)
+	assert.Nil(t, key)
+}
+
+func TestParametersHashLoadsKeyLazily(t *testing.T) {
+	t.Parallel()
+	client := fake.NewFakeClientWithScheme(schemas(t))
+	hashKey := newHashKey(client, client, "")
+
+	hash, err := parametersHash(context.Background(), hashKey, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "", hash)
+	var secrets corev1.SecretList
+	require.NoError(t, client.List(context.Background(), &secrets))
+	assert.Empty(t, secrets.Items, "Key should not be created without parameters to hash")
+
+	_, err = parametersHash(context.Background(), hashKey, map[string]interface{}{"a": "b"})
+	require.NoError(t, err)
+	require.NoError(t, client.List(context.Background(), &secrets))
+	require.Len(t, secrets.Items, 1)
+	assert.Equal(t, fallbackNamespace, secrets.Items[0].Namespace)
+}
+
+func TestServiceMigrateStatusParameters(t *testing.T) {
+	t.Parallel()
+	params := []ibmcloudv1.Param{
+		{Name: "admin_password", Value: &ibmcloudv1.ParamValue{RawMessage: json.RawMessage(`"secret"`)}},
+		{Name: "members", Value: &ibmcloudv1.ParamValue{RawMessage: json.RawMessage(`3`)}},
+	}
+
+	service := &ibmcloudv1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "myservice", Namespace: "mynamespace"},
+		Spec:       ibmcloudv1.ServiceSpec{Parameters: params},
+		Status:     ibmcloudv1.ServiceStatus{Parameters: params},
+	}
+	assert.True(t, migrateStatusParameters(service))
+	assert.Nil(t, service.Status.Parameters)
+	assert.Equal(t, []string{"admin_password", "members"}, service.Status.ParameterNames)
+	assert.Equal(t, "", service.Status.ParametersHash, "Migrated parameters should be re-applied once")
+	assert.False(t, migrateStatusParameters(service), "Migrated status should not change again")
+}
+
+func TestRequestsForParamSource(t *testing.T) {
+	t.Parallel()
+	secretParams := []ibmcloudv1.Param{{
+		Name: "password",
+		ValueFrom: &ibmcloudv1.ParamSource{
+			SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "mysecret"}, Key: "password"},
+		},
+	}}
+	configMapParams := []ibmcloudv1.Param{{
+		Name: "plan",
+		ValueFrom: &ibmcloudv1.ParamSource{
+			ConfigMapKeyRef: &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "mysecret"}, Key: "plan"},
+		},
+	}}
+	scheme := schemas(t)
+	client := fake.NewFakeClientWithScheme(scheme,
+		&ibmcloudv1.Service{ObjectMeta: metav1.ObjectMeta{Name: "service1", Namespace: "app1"}, Spec: ibmcloudv1.ServiceSpec{Parameters: secretParams}},
+		&ibmcloudv1.Service{ObjectMeta: metav1.ObjectMeta{Name: "service2", Namespace: "app1"}, Spec: ibmcloudv1.ServiceSpec{Parameters: configMapParams}},
+		&ibmcloudv1.Service{ObjectMeta: metav1.ObjectMeta{Name: "service3", Namespace: "app2"}, Spec: ibmcloudv1.ServiceSpec{Parameters: secretParams}},
+		&ibmcloudv1.Binding{ObjectMeta: metav1.ObjectMeta{Name: "binding1", Namespace: "app1"}, Spec: ibmcloudv1.BindingSpec{Parameters: secretParams}},
+		&ibmcloudv1.Binding{ObjectMeta: metav1.ObjectMeta{Name: "binding2", Namespace: "app1"}},
+		&ibmcloudv1.Binding{ObjectMeta: metav1.ObjectMeta{Name: "binding3", Namespace: "app3"}, Spec: ibmcloudv1.BindingSpec{
+			Credentials: []ibmcloudv1.BindingCredentials{{Name: "writer", Parameters: configMapParams}},
+		}},
+	)
+	source := func(namespace string) handler.MapObject {
+		obj := &metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{Name: "mysecret", Namespace: namespace}}
+		return handler.MapObject{Meta: obj, Object: obj}
+	}
+	request := func(namespace, name string) reconcile.Request {
+		return reconcile.Request{NamespacedName: types.NamespacedName{Namespace: namespace, Name: name}}
+	}
+
+	serviceReconciler := &ServiceReconciler{Client: client, Log: testLogger(t), Scheme: scheme}
+	assert.Equal(t, []reconcile.Request{request("app1", "service1")}, serviceReconciler.requestsForParamSource(secretParamSource)(source("app1")))
+	assert.Equal(t, []reconcile.Request{request("app1", "service2")}, serviceReconciler.requestsForParamSource(configMapParamSource)(source("app1")))
+	assert.ElementsMatch(t, []reconcile.Request{request("app1", "service1"), request("app2", "service3")}, serviceReconciler.requestsForParamSource(secretParamSource)(source("default")),
+		"Services in any namespace may fall back to the default namespace")
+	serviceReconciler.StrictNamespaces = true
+	assert.Empty(t, serviceReconciler.requestsForParamSource(secretParamSource)(source("default")))
+
+	bindingReconciler := &BindingReconciler{Client: client, Log: testLogger(t), Scheme: scheme}
+	assert.Equal(t, []reconcile.Request{request("app1", "binding1")}, bindingReconciler.requestsForParamSource(secretParamSource)(source("app1")))
+	assert.Empty(t, bindingReconciler.requestsForParamSource(secretParamSource)(source("app2")))
+	assert.Equal(t, []reconcile.Request{request("app3", "binding3")}, bindingReconciler.requestsForParamSource(configMapParamSource)(source("app3")),
+		"Bindings should be reconciled when the parameters of their additional credentials change")
+}
@@ -0,0 +1,49 @@
+package controllers
+
+import (
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/metadata"
+	"k8s.io/client-go/metadata/metadatainformer"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// ParamSources watches the secrets and configmaps that parameters read their values from.
+// Only their metadata is listed and watched, so none of their contents are cached, and the controllers sharing it share one watch per kind.
+type ParamSources struct {
+	mu         sync.Mutex
+	secrets    source.Source
+	configMaps source.Source
+}
+
+// Watches returns sources of events for all secrets and configmaps, creating their informers and adding them to the manager on first use.
+// A nil ParamSources creates informers used only by the caller.
+func (s *ParamSources) Watches(mgr ctrl.Manager) (secrets, configMaps source.Source, err error) {
+	if s == nil {
+		s = &ParamSources{}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.secrets == nil {
+		client, err := metadata.NewForConfig(mgr.GetConfig())
+		if err != nil {
+			return nil, nil, err
+		}
+		factory := metadatainformer.NewSharedInformerFactory(client, 0)
+		secrets := &source.Informer{Informer: factory.ForResource(corev1.SchemeGroupVersion.WithResource("secrets")).Informer()}
+		configMaps := &source.Informer{Informer: factory.ForResource(corev1.SchemeGroupVersion.WithResource("configmaps")).Informer()}
+		err = mgr.Add(manager.RunnableFunc(func(stop <-chan struct{}) error {
+			factory.Start(stop)
+			<-stop
+			return nil
+		}))
+		if err != nil {
+			return nil, nil, err
+		}
+		s.secrets, s.configMaps = secrets, configMaps
+	}
+	return s.secrets, s.configMaps, nil
+}
@@ -0,0 +1,28 @@
+package controllers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamSourcesWatches(t *testing.T) {
+	t.Parallel()
+	mgr := &mockManager{T: t}
+	paramSources := &ParamSources{}
+
+	secrets, configMaps, err := paramSources.Watches(mgr)
+	require.NoError(t, err)
+	assert.NotNil(t, secrets)
+	assert.NotNil(t, configMaps)
+
+	sameSecrets, sameConfigMaps, err := paramSources.Watches(mgr)
+	require.NoError(t, err)
+	assert.Same(t, secrets, sameSecrets, "Controllers should share one watch of secrets")
+	assert.Same(t, configMaps, sameConfigMaps, "Controllers should share one watch of configmaps")
+
+	otherSecrets, _, err := (*ParamSources)(nil).Watches(mgr)
+	require.NoError(t, err)
+	assert.NotSame(t, secrets, otherSecrets)
+}
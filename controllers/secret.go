@@ -2,7 +2,11 @@ package controllers
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"sort"
 
 	"github.com/go-logr/logr"
 	ibmcloudv1 "github.com/ibm/cloud-operators/api/v1"
@@ -11,6 +15,31 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// fallbackNamespace is checked for a referenced secret or configmap missing from the resource's namespace, unless strict namespaces are enabled
+const fallbackNamespace = "default"
+
+// secretHashAnnotation holds the hash of a Binding secret's contents, which changes whenever the credentials do
+const secretHashAnnotation = "ibmcloud.ibm.com/secretHash"
+
+// secretHash returns an HMAC-SHA-256 of the secret data, independent of key order.
+// The hash is published in statuses and annotations, so it is keyed with the operator's hash key to keep it from being used to guess the credentials.
+func secretHash(key []byte, data map[string][]byte) string {
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	hash := hmac.New(sha256.New, key)
+	for _, key := range keys {
+		hash.Write([]byte(key))
+		hash.Write([]byte{0})
+		hash.Write(data[key])
+		hash.Write([]byte{0})
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
 // getSecret takes a name and namespace for a Binding and returns the corresponding secret
 func getSecret(r client.Client, binding *ibmcloudv1.Binding) (*v1.Secret, error) {
 	secretName := binding.Name
@@ -32,8 +61,8 @@ func getKubeSecret(ctx context.Context, r client.Client, logt logr.Logger, secre
 
 	var secret v1.Secret
 	if err := r.Get(ctx, client.ObjectKey{Namespace: namespace, Name: secretname}, &secret); err != nil {
-		if namespace != "default" && fallback {
-			if err := r.Get(ctx, client.ObjectKey{Namespace: "default", Name: secretname}, &secret); err != nil {
+		if namespace != fallbackNamespace && fallback {
+			if err := r.Get(ctx, client.ObjectKey{Namespace: fallbackNamespace, Name: secretname}, &secret); err != nil {
 				log.V(5).Info("secret not found")
 				return nil, err
 			}
@@ -55,3 +84,12 @@ func getKubeSecretValue(ctx context.Context, r client.Client, logt logr.Logger,
 
 	return secret.Data[key], nil
 }
+
+// missingRefError describes a referenced secret or configmap which could not be found.
+// If the lookup did not fall back to the default namespace, the error says so.
+func missingRefError(kind, name string, fallback bool, namespace string) error {
+	if fallback || namespace == fallbackNamespace {
+		return fmt.Errorf("Missing %s %s", kind, name)
+	}
+	return fmt.Errorf("Missing %s %s in namespace %s: strict namespaces are enabled, so namespace %s was not checked", kind, name, namespace, fallbackNamespace)
+}
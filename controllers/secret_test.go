@@ -0,0 +1,17 @@
+package controllers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSecretHash(t *testing.T) {
+	t.Parallel()
+	hash := secretHash(nil, map[string][]byte{"a": []byte("1"), "b": []byte("2")})
+	assert.Len(t, hash, 64)
+	assert.Equal(t, hash, secretHash(nil, map[string][]byte{"b": []byte("2"), "a": []byte("1")}), "Hash should not depend on key order")
+	assert.NotEqual(t, hash, secretHash(nil, map[string][]byte{"a": []byte("1"), "b": []byte("3")}))
+	assert.NotEqual(t, secretHash(nil, map[string][]byte{"ab": []byte("c")}), secretHash(nil, map[string][]byte{"a": []byte("bc")}))
+	assert.NotEqual(t, hash, secretHash([]byte("key"), map[string][]byte{"a": []byte("1"), "b": []byte("2")}), "Hash should depend on the operator's key")
+}
@@ -31,6 +31,7 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 
 	ibmcloudv1 "github.com/ibm/cloud-operators/api/v1"
 	"github.com/ibm/cloud-operators/internal/config"
@@ -58,6 +59,12 @@ type ServiceReconciler struct {
 	client.Client
 	Log    logr.Logger
 	Scheme *runtime.Scheme
+	// StrictNamespaces disables falling back to the default namespace for referenced secrets and configmaps
+	StrictNamespaces bool
+	// HashKey is the operator's key for the hash of resolved parameters recorded in the status
+	HashKey *HashKey
+	// ParamSources watches the secrets and configmaps read by parameters, shared with the other controllers so each is watched once
+	ParamSources *ParamSources
 
 	CreateCFServiceInstance         cfservice.InstanceCreator
 	CreateResourceServiceInstance   resource.ServiceInstanceCreator
@@ -66,14 +73,21 @@ type ServiceReconciler struct {
 	GetCFServiceInstance            cfservice.InstanceGetter
 	GetIBMCloudInfo                 IBMCloudInfoGetter
 	GetResourceServiceAliasInstance resource.ServiceAliasInstanceGetter
+	GetResourceServiceInstance      resource.ServiceInstanceGetter
 	GetResourceServiceInstanceState resource.ServiceInstanceStatusGetter
 	UpdateResourceServiceInstance   resource.ServiceInstanceUpdater
 }
 
 func (r *ServiceReconciler) SetupWithManager(mgr ctrl.Manager, options controller.Options) error {
+	secrets, configMaps, err := r.ParamSources.Watches(mgr)
+	if err != nil {
+		return err
+	}
 	return ctrl.NewControllerManagedBy(mgr).
 		WithOptions(options).
 		For(&ibmcloudv1.Service{}).
+		Watches(secrets, &handler.EnqueueRequestsFromMapFunc{ToRequests: r.requestsForParamSource(secretParamSource)}).
+		Watches(configMaps, &handler.EnqueueRequestsFromMapFunc{ToRequests: r.requestsForParamSource(configMapParamSource)}).
 		Complete(r)
 }
 
@@ -100,11 +114,31 @@ func (r *ServiceReconciler) Reconcile(request ctrl.Request) (ctrl.Result, error)
 		return ctrl.Result{}, err
 	}
 
+	// Remove parameter values copied into the status by earlier versions
+	if migrateStatusParameters(instance) {
+		logt.Info("Removing parameter values from status", "Parameters", instance.Status.ParameterNames)
+		if err := r.Status().Update(ctx, instance); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Remove the spec and status copied into the conversion data by earlier versions, keeping only fields v1 can't represent
+	if pruned, err := ibmcloudv1.PruneConversionData(instance); err != nil {
+		logt.Error(err, "Failed to read conversion data", "annotation", ibmcloudv1.ConversionDataAnnotation)
+	} else if pruned {
+		logt.Info("Removing v1 fields from conversion data", "annotation", ibmcloudv1.ConversionDataAnnotation)
+		if err := r.Update(ctx, instance); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
 	// Enforce immutability, restore the spec if it has changed
 	if specChanged(instance) {
 		logt.Info("Spec is immutable", "Restoring", instance.ObjectMeta.Name)
-		instance.Spec.Plan = instance.Status.Plan
-		instance.Spec.ExternalName = instance.Status.ExternalName
+		if !canUpdateInPlace(instance) {
+			instance.Spec.Plan = instance.Status.Plan
+			instance.Spec.ExternalName = instance.Status.ExternalName
+		}
 		instance.Spec.ServiceClass = instance.Status.ServiceClass
 		instance.Spec.ServiceClassType = instance.Status.ServiceClassType
 		instance.Spec.Context = instance.Status.Context
@@ -123,7 +157,7 @@ func (r *ServiceReconciler) Reconcile(request ctrl.Request) (ctrl.Result, error)
 		targetCRN string
 	)
 	{
-		ibmCloudInfo, err := r.GetIBMCloudInfo(logt, r.Client, instance)
+		ibmCloudInfo, err := r.GetIBMCloudInfo(logt, r.Client, instance, r.StrictNamespaces)
 		if err != nil {
 			// If secrets have already been deleted and we are in a deletion flow, just delete the finalizers
 			// to not prevent object from finalizing. This would cause orphaned service in IBM Cloud.
@@ -139,7 +173,7 @@ func (r *ServiceReconciler) Reconcile(request ctrl.Request) (ctrl.Result, error)
 				return ctrl.Result{}, nil
 			}
 			logt.Error(err, "Failed to get IBM Cloud info for service")
-			return r.updateStatusError(instance, serviceStateFailed, err)
+			return r.updateStatusError(instance, serviceStateFailed, instance.Status.ParametersHash, err)
 		}
 		resourceContext = ibmCloudInfo.Context
 		resourceGroupID = ibmCloudInfo.ResourceGroupID
@@ -162,6 +196,7 @@ func (r *ServiceReconciler) Reconcile(request ctrl.Request) (ctrl.Result, error)
 		instance.Status.State = serviceStatePending
 		instance.Status.Message = "Processing Resource"
 		//setStatusFieldsFromSpec(instance, ibmCloudInfo)
+		setServiceConditions(instance, instance.Status.ParametersHash)
 		if err := r.Status().Update(ctx, instance); err != nil {
 			logt.Info("Failed setting status for the first time", "error", err.Error())
 			return ctrl.Result{}, err
@@ -182,6 +217,13 @@ func (r *ServiceReconciler) Reconcile(request ctrl.Request) (ctrl.Result, error)
 	} else {
 		// The object is being deleted
 		if containsServiceFinalizer(instance) {
+			if !ibmcloudv1.IsConditionTrue(instance.Status.Conditions, ibmcloudv1.ConditionDeleting) {
+				setServiceConditions(instance, instance.Status.ParametersHash)
+				if err := r.Status().Update(ctx, instance); err != nil {
+					logt.Info("Failed setting deleting condition", "error", err.Error())
+					return ctrl.Result{}, err
+				}
+			}
 			err := r.deleteService(session, logt, instance, serviceClassType)
 			if err != nil {
 				logt.Error(err, "Error deleting resource", "service", instance.ObjectMeta.Name)
@@ -227,10 +269,14 @@ func (r *ServiceReconciler) Reconcile(request ctrl.Request) (ctrl.Result, error)
 	*/
 
 	externalName := getExternalName(instance)
-	params, err := r.getParams(ctx, instance)
+	params, err := r.getParams(ctx, instance.Spec.Parameters, instance.Namespace)
 	if err != nil {
 		logt.Error(err, "Instance has problems with its parameters", "service", instance.ObjectMeta.Name)
-		return r.updateStatusError(instance, serviceStateFailed, err)
+		return r.updateStatusError(instance, serviceStateFailed, instance.Status.ParametersHash, err)
+	}
+	paramsHash, err := parametersHash(ctx, r.HashKey, params)
+	if err != nil {
+		return r.updateStatusError(instance, serviceStateFailed, instance.Status.ParametersHash, err)
 	}
 	tags := getTags(instance)
 	logt.Info("ServiceInstance ", "name", externalName, "tags", tags)
@@ -238,49 +284,50 @@ func (r *ServiceReconciler) Reconcile(request ctrl.Request) (ctrl.Result, error)
 	if serviceClassType == "CF" {
 		logt.Info("ServiceInstance is CF", "instance", instance.ObjectMeta.Name)
 		if instance.Status.InstanceID == "" { // ServiceInstance has not been created on Bluemix
-			// check if using the alias plan, in that case we need to use the existing instance
-			if isAlias(instance) {
-				logt.Info("Using `Alias` plan, checking if instance exists")
+			// check if using the alias plan or observing, in that case we need to use the existing instance
+			if isReadOnly(instance) {
+				logt.Info("Using `Alias` plan or Observe policy, checking if instance exists")
 
 				instanceID, _, err := r.GetCFServiceInstance(session, externalName)
 				if err != nil {
-					logt.Error(err, "Instance ", instance.ObjectMeta.Name, " with `Alias` plan does not exists")
-					return r.updateStatusError(instance, serviceStateFailed, err)
+					logt.Error(err, "Instance ", instance.ObjectMeta.Name, " with `Alias` plan or Observe policy does not exists")
+					return r.updateStatusError(instance, serviceStateFailed, paramsHash, err)
 				}
-				return r.updateStatus(session, logt, instance, resourceContext, instanceID, serviceStateOnline, serviceClassType)
+				return r.updateStatus(session, logt, instance, resourceContext, instanceID, serviceStateOnline, serviceClassType, paramsHash, instance.Status.ParametersHash)
 			}
 			// Service is not Alias
 			logt.Info("Creating", "instance", instance.ObjectMeta.Name, "service class", instance.Spec.ServiceClass)
 			guid, state, err := r.CreateCFServiceInstance(session, externalName, servicePlanID, spaceID, params, tags)
 			if err != nil {
-				return r.updateStatusError(instance, serviceStateFailed, err)
+				return r.updateStatusError(instance, serviceStateFailed, paramsHash, err)
 			}
-			return r.updateStatus(session, logt, instance, resourceContext, guid, state, serviceClassType)
+			return r.updateStatus(session, logt, instance, resourceContext, guid, state, serviceClassType, paramsHash, paramsHash)
 		}
 		// ServiceInstance was previously created, verify that it is still there
 		logt.Info("CF ServiceInstance ", "should already exists, verifying", instance.ObjectMeta.Name)
 		_, state, err := r.GetCFServiceInstance(session, externalName)
-		if err != nil && !isAlias(instance) {
+		if err != nil && !isReadOnly(instance) {
 			if _, notFound := err.(cfservice.NotFoundError); notFound {
 				logt.Info("Recreating ", instance.ObjectMeta.Name, instance.Spec.ServiceClass)
 
 				guid, state, err := r.CreateCFServiceInstance(session, externalName, servicePlanID, spaceID, params, tags)
 				if err != nil {
-					return r.updateStatusError(instance, serviceStateFailed, err)
+					return r.updateStatusError(instance, serviceStateFailed, paramsHash, err)
 				}
-				return r.updateStatus(session, logt, instance, resourceContext, guid, state, serviceClassType)
+				return r.updateStatus(session, logt, instance, resourceContext, guid, state, serviceClassType, paramsHash, paramsHash)
 			}
-			return r.updateStatusError(instance, serviceStateFailed, err)
-		} else if err != nil && isAlias(instance) {
+			return r.updateStatusError(instance, serviceStateFailed, paramsHash, err)
+		} else if err != nil && isReadOnly(instance) {
 			// reset the service instance ID, since it's gone
 			instance.Status.InstanceID = ""
-			return r.updateStatusError(instance, serviceStatePending, err)
+			return r.updateStatusError(instance, serviceStatePending, paramsHash, err)
 		}
 
 		logt.Info("ServiceInstance ", "exists", instance.ObjectMeta.Name)
 
 		// Verification was successful, service exists, update the status if necessary
-		return r.updateStatus(session, logt, instance, resourceContext, instance.Status.InstanceID, state, serviceClassType)
+		// CF services do not re-apply changed parameters
+		return r.updateStatus(session, logt, instance, resourceContext, instance.Status.InstanceID, state, serviceClassType, paramsHash, instance.Status.ParametersHash)
 
 	}
 
@@ -290,26 +337,41 @@ func (r *ServiceReconciler) Reconcile(request ctrl.Request) (ctrl.Result, error)
 	}
 
 	if instance.Status.InstanceID == "" { // ServiceInstance has not been created on Bluemix
-		// check if using the alias plan, in that case we need to use the existing instance
-		if isAlias(instance) {
+		// check if using the alias plan or observing, in that case we need to use the existing instance
+		if isReadOnly(instance) {
 			logt := logt.WithValues("Name", instance.ObjectMeta.Name)
-			logt.Info("Using `Alias` plan, checking if instance exists")
+			logt.Info("Using `Alias` plan or Observe policy, checking if instance exists")
 
 			// check if there is an annotation for service ID
 			instanceID := instance.ObjectMeta.GetAnnotations()[instanceIDKey]
 
+			lookupKind := "Alias plan"
+			if isObserved(instance) {
+				lookupKind = "Observe policy"
+			}
 			id, state, err := r.GetResourceServiceAliasInstance(session, instanceID, resourceGroupID, servicePlanID, externalName, logt)
 			if _, notFound := err.(resource.NotFoundError); notFound {
-				return r.updateStatusError(instance, serviceStateFailed, errors.Wrapf(err, "no service instances with name %s found for alias plan", instance.ObjectMeta.Name))
+				return r.updateStatusError(instance, serviceStateFailed, paramsHash, errors.Wrapf(err, "no service instances with name %s found for %s", instance.ObjectMeta.Name, strings.ToLower(lookupKind)))
 			}
 			if err != nil {
-				return r.updateStatusError(instance, serviceStateFailed, errors.Wrapf(err, "failed to resolve Alias plan instance %s", instance.ObjectMeta.Name))
+				return r.updateStatusError(instance, serviceStateFailed, paramsHash, errors.Wrapf(err, "failed to resolve %s instance %s", lookupKind, instance.ObjectMeta.Name))
 			}
-			return r.updateStatus(session, logt, instance, resourceContext, id, state, serviceClassType)
+			return r.updateStatus(session, logt, instance, resourceContext, id, state, serviceClassType, paramsHash, instance.Status.ParametersHash)
+		}
+
+		// Adopt the existing instance if requested, service is not alias
+		if instanceID := instance.Spec.AdoptInstanceID; instanceID != "" {
+			logt.Info("Adopting existing service instance", "InstanceID", instanceID)
+			state, err := r.adoptServiceInstance(ctx, session, instance, instanceID, servicePlanID, externalName, params, tags)
+			if err != nil {
+				return r.updateStatusError(instance, serviceStateFailed, paramsHash, errors.Wrapf(err, "failed to adopt service instance %s", instanceID))
+			}
+			return r.updateStatus(session, logt, instance, resourceContext, instanceID, state, serviceClassType, paramsHash, paramsHash)
 		}
 
 		// Create the instance, service is not alias
 		instance.Status.InstanceID = inProgress
+		setServiceConditions(instance, paramsHash)
 		if err := r.Status().Update(ctx, instance); err != nil {
 			logt.Info("Error updating InstanceID to be in progress", "Error", err.Error())
 			return ctrl.Result{}, err
@@ -318,50 +380,137 @@ func (r *ServiceReconciler) Reconcile(request ctrl.Request) (ctrl.Result, error)
 		logt.Info("Creating ", instance.ObjectMeta.Name, instance.Spec.ServiceClass)
 		id, state, err := createServiceInstance()
 		if err != nil {
-			return r.updateStatusError(instance, serviceStateFailed, err)
+			return r.updateStatusError(instance, serviceStateFailed, paramsHash, err)
 		}
-		return r.updateStatus(session, logt, instance, resourceContext, id, state, serviceClassType)
+		return r.updateStatus(session, logt, instance, resourceContext, id, state, serviceClassType, paramsHash, paramsHash)
 	}
 
 	// ServiceInstance was previously created, verify that it is still there
 	logt.Info("ServiceInstance ", "should already exists, verifying", instance.ObjectMeta.Name)
 
-	state, err := r.GetResourceServiceInstanceState(session, resourceGroupID, servicePlanID, externalName, instance.Status.InstanceID)
+	lookupPlanID := servicePlanID
+	if planChanged(instance) || ibmcloudv1.FindCondition(instance.Status.Conditions, ibmcloudv1.ConditionPlanUpdated) != nil {
+		// the instance may still be listed under its previous plan, so only match on its ID
+		lookupPlanID = ""
+	}
+	lookupName := externalName
+	if externalNameChanged(instance) {
+		// the instance keeps its previous name until the update below succeeds
+		lookupName = getStatusExternalName(instance)
+	}
+	state, err := r.GetResourceServiceInstanceState(session, resourceGroupID, lookupPlanID, lookupName, instance.Status.InstanceID)
 	if _, ok := err.(resource.NotFoundError); ok { // Need to recreate it!
-		if !isAlias(instance) {
+		if !isReadOnly(instance) {
 			logt.Info("Recreating ", instance.ObjectMeta.Name, instance.Spec.ServiceClass)
 			instance.Status.InstanceID = inProgress
+			setServiceConditions(instance, paramsHash)
 			if err := r.Status().Update(ctx, instance); err != nil {
 				logt.Info("Error updating instanceID to be in progress", "Error", err.Error())
 				return ctrl.Result{}, err
 			}
 			id, state, err := createServiceInstance()
 			if err != nil {
-				return r.updateStatusError(instance, serviceStateFailed, err)
+				return r.updateStatusError(instance, serviceStateFailed, paramsHash, err)
 			}
-			return r.updateStatus(session, logt, instance, resourceContext, id, state, serviceClassType)
+			return r.updateStatus(session, logt, instance, resourceContext, id, state, serviceClassType, paramsHash, paramsHash)
 		}
 		instance.Status.InstanceID = ""
-		return r.updateStatusError(instance, serviceStatePending, fmt.Errorf("aliased service instance no longer exists"))
+		if isObserved(instance) {
+			return r.updateStatusError(instance, serviceStatePending, paramsHash, fmt.Errorf("observed service instance no longer exists"))
+		}
+		return r.updateStatusError(instance, serviceStatePending, paramsHash, fmt.Errorf("aliased service instance no longer exists"))
 	}
 	if err != nil {
-		return r.updateStatusError(instance, serviceStatePending, err)
+		return r.updateStatusError(instance, serviceStatePending, paramsHash, err)
 	}
 
 	logt.Info("ServiceInstance ", "exists", instance.ObjectMeta.Name)
 
-	// Update Params and Tags if they have changed
-	if tagsOrParamsChanged(instance) {
+	// Update Plan, Name, Params and Tags if they have changed
+	renamed := externalNameChanged(instance)
+	appliedHash := instance.Status.ParametersHash
+	if planChanged(instance) {
+		logt.Info("ServiceInstance ", "updating plan", instance.ObjectMeta.Name, "from", instance.Status.Plan, "to", instance.Spec.Plan)
+		setServiceConditions(instance, paramsHash)
+		if err := r.Status().Update(ctx, instance); err != nil {
+			logt.Info("Error updating plan condition", "Error", err.Error())
+			return ctrl.Result{}, err
+		}
+		state, err = r.UpdateResourceServiceInstance(session, instance.Status.InstanceID, externalName, servicePlanID, params, tags)
+		if err != nil {
+			logt.Info("Error updating plan", "Error", err.Error())
+			return r.updateStatusError(instance, serviceStateFailed, paramsHash, errors.Wrapf(err, "failed to change plan from %s to %s", instance.Status.Plan, instance.Spec.Plan))
+		}
+		appliedHash = paramsHash
+	} else if renamed {
+		logt.Info("ServiceInstance ", "renaming", instance.ObjectMeta.Name, "from", getStatusExternalName(instance), "to", externalName)
+		state, err = r.UpdateResourceServiceInstance(session, instance.Status.InstanceID, externalName, servicePlanID, params, tags)
+		if err != nil {
+			logt.Info("Error renaming", "Error", err.Error())
+			return r.updateStatusError(instance, serviceStateFailed, paramsHash, errors.Wrapf(err, "failed to rename service instance from %s to %s", getStatusExternalName(instance), externalName))
+		}
+		appliedHash = paramsHash
+	} else if (tagsOrParamsChanged(instance) || parametersHashChanged(instance, paramsHash)) && !isObserved(instance) {
 		logt.Info("ServiceInstance ", "updating tags and/or parameters", instance.ObjectMeta.Name)
 		state, err = r.UpdateResourceServiceInstance(session, instance.Status.InstanceID, externalName, servicePlanID, params, tags)
 		if err != nil {
 			logt.Info("Error updating tags and/or parameters", "Error", err.Error())
-			return r.updateStatusError(instance, serviceStateFailed, err)
+			return r.updateStatusError(instance, serviceStateFailed, paramsHash, err)
 		}
+		appliedHash = paramsHash
+	}
+	if renamed {
+		instance.Status.PreviousExternalName = getStatusExternalName(instance)
 	}
 
 	// Verification was successful, service exists, update the status if necessary
-	return r.updateStatus(session, logt, instance, resourceContext, instance.Status.InstanceID, state, serviceClassType)
+	return r.updateStatus(session, logt, instance, resourceContext, instance.Status.InstanceID, state, serviceClassType, paramsHash, appliedHash)
+}
+
+// adoptServiceInstance verifies no other Service manages the existing instance and that it matches the spec's service class and plan,
+// then updates it with the spec's name, parameters and tags as if the operator had created it.
+// Alias and observed Services only read the instance, so they do not prevent adopting it.
+// If several Services adopt the same instance, the one created first adopts it.
+func (r *ServiceReconciler) adoptServiceInstance(ctx context.Context, session *session.Session, instance *ibmcloudv1.Service, instanceID, servicePlanID, externalName string, params map[string]interface{}, tags []string) (state string, err error) {
+	var services ibmcloudv1.ServiceList
+	if err := r.List(ctx, &services); err != nil {
+		return "", err
+	}
+	for i := range services.Items {
+		service := &services.Items[i]
+		if (service.Namespace == instance.Namespace && service.Name == instance.Name) || isReadOnly(service) {
+			continue
+		}
+		if service.Status.InstanceID == instanceID {
+			return "", fmt.Errorf("instance is already used by Service %s/%s", service.Namespace, service.Name)
+		}
+		if service.Spec.AdoptInstanceID == instanceID && createdBefore(service, instance) {
+			return "", fmt.Errorf("instance is being adopted by Service %s/%s, which was created first", service.Namespace, service.Name)
+		}
+	}
+
+	serviceClass, instancePlanID, _, err := r.GetResourceServiceInstance(session, instanceID)
+	if err != nil {
+		return "", err
+	}
+	if serviceClass != instance.Spec.ServiceClass {
+		return "", fmt.Errorf("instance has service class %s, expected %s", serviceClass, instance.Spec.ServiceClass)
+	}
+	if instancePlanID != servicePlanID {
+		return "", fmt.Errorf("instance plan does not match plan %s", instance.Spec.Plan)
+	}
+	return r.UpdateResourceServiceInstance(session, instanceID, externalName, servicePlanID, params, tags)
+}
+
+// createdBefore returns true if a was created before b, ordering Services created at the same time by namespace and name
+func createdBefore(a, b *ibmcloudv1.Service) bool {
+	if !a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+		return a.CreationTimestamp.Before(&b.CreationTimestamp)
+	}
+	if a.Namespace != b.Namespace {
+		return a.Namespace < b.Namespace
+	}
+	return a.Name < b.Name
 }
 
 func specChanged(instance *ibmcloudv1.Service) bool {
@@ -372,10 +521,10 @@ func specChanged(instance *ibmcloudv1.Service) bool {
 	if instance.Status.Plan == "" {
 		return false
 	}
-	if instance.Spec.ExternalName != instance.Status.ExternalName {
+	if instance.Spec.ExternalName != instance.Status.ExternalName && !canUpdateInPlace(instance) {
 		return true
 	}
-	if instance.Spec.Plan != instance.Status.Plan {
+	if instance.Spec.Plan != instance.Status.Plan && !canUpdateInPlace(instance) {
 		return true
 	}
 	if instance.Spec.ServiceClass != instance.Status.ServiceClass {
@@ -392,6 +541,26 @@ func specChanged(instance *ibmcloudv1.Service) bool {
 	return false
 }
 
+// canUpdateInPlace returns true if the service instance can be moved to a new plan or renamed in place.
+// Only resource controller services support this, and never to or from the Alias plan.
+func canUpdateInPlace(instance *ibmcloudv1.Service) bool {
+	return instance.Spec.ServiceClassType != "CF" &&
+		!isAlias(instance) &&
+		strings.ToLower(instance.Status.Plan) != aliasPlan
+}
+
+// planChanged returns true if the plan of an existing service instance should be updated
+func planChanged(instance *ibmcloudv1.Service) bool {
+	return isServiceProvisioned(instance) && instance.Status.Plan != "" && !isObserved(instance) &&
+		instance.Spec.Plan != instance.Status.Plan && canUpdateInPlace(instance)
+}
+
+// externalNameChanged returns true if an existing service instance should be renamed
+func externalNameChanged(instance *ibmcloudv1.Service) bool {
+	return isServiceProvisioned(instance) && instance.Status.Plan != "" && !isObserved(instance) &&
+		getExternalName(instance) != getStatusExternalName(instance) && canUpdateInPlace(instance)
+}
+
 // containsServiceFinalizer checks if the instance contains service finalizer
 func containsServiceFinalizer(instance *ibmcloudv1.Service) bool {
 	for _, finalizer := range instance.ObjectMeta.Finalizers {
@@ -414,7 +583,9 @@ func deleteServiceFinalizer(instance *ibmcloudv1.Service) []string {
 	return result
 }
 
-func (r *ServiceReconciler) updateStatusError(instance *ibmcloudv1.Service, state string, err error) (ctrl.Result, error) {
+// updateStatusError records the error in the status. paramsHash is the hash of the resolved parameters, or the recorded hash if they
+// could not be resolved, so the ParametersApplied condition stays false until a pending change is applied.
+func (r *ServiceReconciler) updateStatusError(instance *ibmcloudv1.Service, state, paramsHash string, err error) (ctrl.Result, error) {
 	logt := r.Log.WithValues("namespacedname", instance.Namespace+"/"+instance.Name)
 	message := err.Error()
 	logt.Error(err, "Updating status with error")
@@ -426,6 +597,7 @@ func (r *ServiceReconciler) updateStatusError(instance *ibmcloudv1.Service, stat
 	if instance.Status.State != state {
 		instance.Status.State = state
 		instance.Status.Message = message
+		setServiceConditions(instance, paramsHash)
 		if err := r.Status().Update(context.Background(), instance); err != nil {
 			logt.Info("Error updating status", state, err.Error())
 			return ctrl.Result{}, err
@@ -440,6 +612,14 @@ func (r *ServiceReconciler) deleteService(session *session.Session, logt logr.Lo
 		logt.Info("Aliased service will not be deleted", "Name", instance.Name)
 		return nil
 	}
+	if isObserved(instance) {
+		logt.Info("Observed service will not be deleted", "Name", instance.Name)
+		return nil
+	}
+	if instance.Spec.DeletionPolicy == ibmcloudv1.DeletionPolicyRetain {
+		logt.Info("Retaining service instance", "Name", instance.Name, "InstanceID", instance.Status.InstanceID)
+		return nil
+	}
 	if instance.Status.InstanceID == "" {
 		return nil // Nothing to do here, service was not intialized
 	}
@@ -460,11 +640,19 @@ func getExternalName(instance *ibmcloudv1.Service) string {
 	return instance.Name
 }
 
-func (r *ServiceReconciler) getParams(ctx context.Context, instance *ibmcloudv1.Service) (map[string]interface{}, error) {
+// getStatusExternalName returns the name the service instance was last given on IBM Cloud
+func getStatusExternalName(instance *ibmcloudv1.Service) string {
+	if instance.Status.ExternalName != "" {
+		return instance.Status.ExternalName
+	}
+	return instance.Name
+}
+
+func (r *ServiceReconciler) getParams(ctx context.Context, parameters []ibmcloudv1.Param, namespace string) (map[string]interface{}, error) {
 	params := make(map[string]interface{})
 
-	for _, p := range instance.Spec.Parameters {
-		val, err := r.paramToJSON(ctx, p, instance.Namespace)
+	for _, p := range parameters {
+		val, err := r.paramToJSON(ctx, p, namespace)
 		if err != nil {
 			return params, err
 		}
@@ -492,18 +680,19 @@ func (r *ServiceReconciler) paramToJSON(ctx context.Context, p ibmcloudv1.Param,
 
 // paramValueToJSON takes a ParamSource and resolves its value
 func (r *ServiceReconciler) paramValueToJSON(ctx context.Context, valueFrom ibmcloudv1.ParamSource, namespace string) (interface{}, error) {
+	fallback := !r.StrictNamespaces
 	if valueFrom.SecretKeyRef != nil {
-		data, err := getKubeSecretValue(ctx, r, r.Log, valueFrom.SecretKeyRef.Name, valueFrom.SecretKeyRef.Key, true, namespace)
+		data, err := getKubeSecretValue(ctx, r, r.Log, valueFrom.SecretKeyRef.Name, valueFrom.SecretKeyRef.Key, fallback, namespace)
 		if err != nil {
 			// Recoverable
-			return nil, fmt.Errorf("Missing secret %s", valueFrom.SecretKeyRef.Name)
+			return nil, missingRefError("secret", valueFrom.SecretKeyRef.Name, fallback, namespace)
 		}
 		return paramToJSONFromString(string(data))
 	} else if valueFrom.ConfigMapKeyRef != nil {
-		data, err := getConfigMapValue(ctx, r, r.Log, valueFrom.ConfigMapKeyRef.Name, valueFrom.ConfigMapKeyRef.Key, true, namespace)
+		data, err := getConfigMapValue(ctx, r, r.Log, valueFrom.ConfigMapKeyRef.Name, valueFrom.ConfigMapKeyRef.Key, fallback, namespace)
 		if err != nil {
 			// Recoverable
-			return nil, fmt.Errorf("Missing configmap %s", valueFrom.ConfigMapKeyRef.Name)
+			return nil, missingRefError("configmap", valueFrom.ConfigMapKeyRef.Name, fallback, namespace)
 		}
 		return paramToJSONFromString(data)
 	}
@@ -518,15 +707,30 @@ func isAlias(instance *ibmcloudv1.Service) bool {
 	return strings.ToLower(instance.Spec.Plan) == aliasPlan
 }
 
-func (r *ServiceReconciler) updateStatus(session *session.Session, logt logr.Logger, instance *ibmcloudv1.Service, resourceContext ibmcloudv1.ResourceContext, instanceID, instanceState, serviceClassType string) (ctrl.Result, error) {
+func isObserved(instance *ibmcloudv1.Service) bool {
+	return instance.Spec.ManagementPolicy == ibmcloudv1.ManagementPolicyObserve
+}
+
+// isReadOnly returns true if the operator must never create or recreate the service instance
+func isReadOnly(instance *ibmcloudv1.Service) bool {
+	return isAlias(instance) || isObserved(instance)
+}
+
+// updateStatus records the instance's state. paramsHash is the hash of the resolved parameters, while appliedHash is the hash of the
+// parameters last sent to IBM Cloud, which differs from paramsHash until a changed value is applied.
+func (r *ServiceReconciler) updateStatus(session *session.Session, logt logr.Logger, instance *ibmcloudv1.Service, resourceContext ibmcloudv1.ResourceContext, instanceID, instanceState, serviceClassType, paramsHash, appliedHash string) (ctrl.Result, error) {
 	r.Log.Info("the instance state", "is:", instanceState)
 	state := getState(instanceState)
-	if instance.Status.State != state || instance.Status.InstanceID != instanceID || tagsOrParamsChanged(instance) {
+	pendingParamsApplied := parametersHashChanged(instance, paramsHash) && ibmcloudv1.IsConditionTrue(instance.Status.Conditions, ibmcloudv1.ConditionParametersApplied)
+	pendingTagsOrParams := tagsOrParamsChanged(instance) && !isObserved(instance)
+	if instance.Status.State != state || instance.Status.InstanceID != instanceID || planChanged(instance) || externalNameChanged(instance) || pendingTagsOrParams || instance.Status.ParametersHash != appliedHash || pendingParamsApplied || instance.Status.ObservedGeneration != instance.Generation {
+		foundInstance := instance.Status.InstanceID != instanceID
 		instance.Status.State = state
 		instance.Status.Message = state
 		instance.Status.InstanceID = instanceID
 		instance.Status.DashboardURL = getDashboardURL(instance.Spec.ServiceClass, instanceID)
-		setStatusFieldsFromSpec(instance, resourceContext)
+		setStatusFieldsFromSpec(instance, resourceContext, appliedHash, foundInstance)
+		setServiceConditions(instance, paramsHash)
 		err := r.Status().Update(context.Background(), instance)
 		if err != nil {
 			return ctrl.Result{}, err
@@ -542,19 +746,35 @@ func getState(serviceInstanceState string) string {
 	return serviceInstanceState
 }
 
-func setStatusFieldsFromSpec(instance *ibmcloudv1.Service, resourceContext ibmcloudv1.ResourceContext) {
-	instance.Status.Plan = instance.Spec.Plan
-	instance.Status.ExternalName = instance.Spec.ExternalName
+// setStatusFieldsFromSpec records the spec as applied to the service instance.
+// Observed instances are never changed: their plan and name are only recorded when the instance is found by them,
+// and their tags and parameter names are left unrecorded, so they are applied if the Service is managed later.
+func setStatusFieldsFromSpec(instance *ibmcloudv1.Service, resourceContext ibmcloudv1.ResourceContext, paramsHash string, foundInstance bool) {
+	if !isObserved(instance) || foundInstance {
+		instance.Status.Plan = instance.Spec.Plan
+		instance.Status.ExternalName = instance.Spec.ExternalName
+	}
 	instance.Status.ServiceClass = instance.Spec.ServiceClass
 	instance.Status.ServiceClassType = instance.Spec.ServiceClassType
-	instance.Status.Parameters = instance.Spec.Parameters
-	instance.Status.Tags = instance.Spec.Tags
+	if !isObserved(instance) {
+		instance.Status.ParameterNames = paramNames(instance.Spec.Parameters)
+		instance.Status.Tags = instance.Spec.Tags
+	}
+	instance.Status.ParametersHash = paramsHash
 	instance.Status.Context = resourceContext
 	instance.Spec.Context = resourceContext
 }
 
+// tagsOrParamsChanged returns true if tags or parameters were added or removed since they were last applied.
+// Changes to parameter values are detected with parametersHashChanged.
 func tagsOrParamsChanged(instance *ibmcloudv1.Service) bool {
-	return !reflect.DeepEqual(instance.Spec.Parameters, instance.Status.Parameters) || !reflect.DeepEqual(instance.Spec.Tags, instance.Status.Tags)
+	return !reflect.DeepEqual(paramNames(instance.Spec.Parameters), instance.Status.ParameterNames) || !reflect.DeepEqual(instance.Spec.Tags, instance.Status.Tags)
+}
+
+// parametersHashChanged returns true if a value read from a secret or configmap has changed since the parameters were last applied.
+// Services with parameters but no recorded hash, such as those migrated from status.parameters, count as changed until updated once.
+func parametersHashChanged(instance *ibmcloudv1.Service, paramsHash string) bool {
+	return instance.Status.ParametersHash != paramsHash
 }
 
 func getDashboardURL(serviceClass, crn string) string {
@@ -151,7 +151,7 @@ func TestService(t *testing.T) {
 func getServiceInstanceFromObjCF(logt logr.Logger, service *ibmcloudv1.Service) (*mccpv2.ServiceInstance, error) {
 	externalName := getExternalName(service)
 
-	ibmCloudInfo, err := ibmcloud.GetInfo(logt, k8sClient, service)
+	ibmCloudInfo, err := ibmcloud.GetInfo(logt, k8sClient, service, false)
 	if err != nil {
 		return &mccpv2.ServiceInstance{}, err
 	}
@@ -164,7 +164,7 @@ func getServiceInstanceFromObjCF(logt logr.Logger, service *ibmcloudv1.Service)
 func getServiceInstanceFromObj(logt logr.Logger, service *ibmcloudv1.Service) (models.ServiceInstance, error) {
 	externalName := getExternalName(service)
 
-	ibmCloudInfo, err := ibmcloud.GetInfo(logt, k8sClient, service)
+	ibmCloudInfo, err := ibmcloud.GetInfo(logt, k8sClient, service, false)
 	if err != nil {
 		return models.ServiceInstance{}, err
 	}
@@ -303,7 +303,8 @@ func TestServiceSpecChangedAndUpdateFailed(t *testing.T) {
 		&ibmcloudv1.Service{
 			ObjectMeta: metav1.ObjectMeta{Name: serviceName, Namespace: namespace},
 			Status: ibmcloudv1.ServiceStatus{
-				Plan: "Lite",
+				Plan:         "Lite",
+				ServiceClass: "service-name",
 			},
 		},
 	}
@@ -354,7 +355,7 @@ func TestServiceGetIBMCloudInfoFailed(t *testing.T) {
 			Log:    testLogger(t),
 			Scheme: scheme,
 
-			GetIBMCloudInfo: func(logt logr.Logger, _ client.Client, instance *ibmcloudv1.Service) (*ibmcloud.Info, error) {
+			GetIBMCloudInfo: func(logt logr.Logger, _ client.Client, instance *ibmcloudv1.Service, _ bool) (*ibmcloud.Info, error) {
 				return nil, errors.NewNotFound(ctrl.GroupResource{Group: "ibmcloud.ibm.com", Resource: "secret"}, "ibmcloud-operator-secret")
 			},
 		}
@@ -391,7 +392,7 @@ func TestServiceGetIBMCloudInfoFailed(t *testing.T) {
 			Log:    testLogger(t),
 			Scheme: scheme,
 
-			GetIBMCloudInfo: func(logt logr.Logger, r client.Client, instance *ibmcloudv1.Service) (*ibmcloud.Info, error) {
+			GetIBMCloudInfo: func(logt logr.Logger, r client.Client, instance *ibmcloudv1.Service, _ bool) (*ibmcloud.Info, error) {
 				return nil, fmt.Errorf("failed")
 			},
 		}
@@ -416,6 +417,12 @@ func TestServiceGetIBMCloudInfoFailed(t *testing.T) {
 				State:   serviceStateFailed,
 				Message: "failed",
 				Plan:    "Lite",
+				Conditions: []ibmcloudv1.Condition{
+					{Type: ibmcloudv1.ConditionReady, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime, Reason: "Failed", Message: "failed"},
+					{Type: ibmcloudv1.ConditionProvisioned, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime, Reason: "Failed", Message: "failed"},
+					{Type: ibmcloudv1.ConditionParametersApplied, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime, Reason: "NotProvisioned"},
+					{Type: ibmcloudv1.ConditionDeleting, Status: corev1.ConditionTrue, LastTransitionTime: testConditionTime, Reason: "Deleting"},
+				},
 			},
 			Spec: ibmcloudv1.ServiceSpec{Plan: "Lite"},
 		}, fakeClient.LastStatusUpdate())
@@ -444,7 +451,7 @@ func TestServiceFirstStatusFailed(t *testing.T) {
 		Log:    testLogger(t),
 		Scheme: scheme,
 
-		GetIBMCloudInfo: func(logt logr.Logger, r client.Client, instance *ibmcloudv1.Service) (*ibmcloud.Info, error) {
+		GetIBMCloudInfo: func(logt logr.Logger, r client.Client, instance *ibmcloudv1.Service, _ bool) (*ibmcloud.Info, error) {
 			return &ibmcloud.Info{}, nil
 		},
 	}
@@ -482,7 +489,7 @@ func TestServiceEnsureFinalizerFailed(t *testing.T) {
 		Log:    testLogger(t),
 		Scheme: scheme,
 
-		GetIBMCloudInfo: func(logt logr.Logger, _ client.Client, instance *ibmcloudv1.Service) (*ibmcloud.Info, error) {
+		GetIBMCloudInfo: func(logt logr.Logger, _ client.Client, instance *ibmcloudv1.Service, _ bool) (*ibmcloud.Info, error) {
 			r.Client = newMockClient(
 				fake.NewFakeClientWithScheme(scheme, objects...),
 				MockConfig{UpdateErr: fmt.Errorf("failed")},
@@ -539,7 +546,7 @@ func TestServiceDeletingFailed(t *testing.T) {
 			Log:    testLogger(t),
 			Scheme: scheme,
 
-			GetIBMCloudInfo: func(logt logr.Logger, _ client.Client, instance *ibmcloudv1.Service) (*ibmcloud.Info, error) {
+			GetIBMCloudInfo: func(logt logr.Logger, _ client.Client, instance *ibmcloudv1.Service, _ bool) (*ibmcloud.Info, error) {
 				r.Client = newMockClient(
 					fake.NewFakeClientWithScheme(scheme, objects...),
 					MockConfig{},
@@ -583,7 +590,7 @@ func TestServiceDeletingFailed(t *testing.T) {
 			Log:    testLogger(t),
 			Scheme: scheme,
 
-			GetIBMCloudInfo: func(logt logr.Logger, _ client.Client, instance *ibmcloudv1.Service) (*ibmcloud.Info, error) {
+			GetIBMCloudInfo: func(logt logr.Logger, _ client.Client, instance *ibmcloudv1.Service, _ bool) (*ibmcloud.Info, error) {
 				r.Client = newMockClient(
 					fake.NewFakeClientWithScheme(scheme, objects...),
 					MockConfig{UpdateErr: fmt.Errorf("failed")},
@@ -607,6 +614,12 @@ func TestServiceDeletingFailed(t *testing.T) {
 			},
 			Status: ibmcloudv1.ServiceStatus{
 				Plan: "Lite",
+				Conditions: []ibmcloudv1.Condition{
+					{Type: ibmcloudv1.ConditionReady, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime, Reason: "Unknown"},
+					{Type: ibmcloudv1.ConditionProvisioned, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime, Reason: "Unknown"},
+					{Type: ibmcloudv1.ConditionParametersApplied, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime, Reason: "NotProvisioned"},
+					{Type: ibmcloudv1.ConditionDeleting, Status: corev1.ConditionTrue, LastTransitionTime: testConditionTime, Reason: "Deleting"},
+				},
 			},
 			Spec: ibmcloudv1.ServiceSpec{Plan: "Lite"},
 		}, r.Client.(MockClient).LastUpdate())
@@ -654,7 +667,7 @@ func TestServiceGetParamsFailed(t *testing.T) {
 		Log:    testLogger(t),
 		Scheme: scheme,
 
-		GetIBMCloudInfo: func(logt logr.Logger, _ client.Client, instance *ibmcloudv1.Service) (*ibmcloud.Info, error) {
+		GetIBMCloudInfo: func(logt logr.Logger, _ client.Client, instance *ibmcloudv1.Service, _ bool) (*ibmcloud.Info, error) {
 			return &ibmcloud.Info{}, nil
 		},
 	}
@@ -675,15 +688,14 @@ func TestServiceGetParamsFailed(t *testing.T) {
 			Finalizers: []string{serviceFinalizer},
 		},
 		Status: ibmcloudv1.ServiceStatus{
-			State:   serviceStateFailed,
-			Message: "Value and ValueFrom properties are mutually exclusive (for hello variable)",
-			Plan:    "Lite",
-			Parameters: []ibmcloudv1.Param{
-				{
-					Name:      "hello",
-					Value:     &ibmcloudv1.ParamValue{RawMessage: json.RawMessage(`"world"`)},
-					ValueFrom: &ibmcloudv1.ParamSource{},
-				},
+			State:          serviceStateFailed,
+			Message:        "Value and ValueFrom properties are mutually exclusive (for hello variable)",
+			Plan:           "Lite",
+			ParameterNames: []string{"hello"},
+			Conditions: []ibmcloudv1.Condition{
+				{Type: ibmcloudv1.ConditionReady, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime, Reason: "Failed", Message: "Value and ValueFrom properties are mutually exclusive (for hello variable)"},
+				{Type: ibmcloudv1.ConditionProvisioned, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime, Reason: "Failed", Message: "Value and ValueFrom properties are mutually exclusive (for hello variable)"},
+				{Type: ibmcloudv1.ConditionParametersApplied, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime, Reason: "NotProvisioned"},
 			},
 		},
 		Spec: ibmcloudv1.ServiceSpec{
@@ -724,7 +736,7 @@ func TestServiceEnsureCFServiceExists(t *testing.T) {
 			Log:    testLogger(t),
 			Scheme: scheme,
 
-			GetIBMCloudInfo: func(logt logr.Logger, _ client.Client, instance *ibmcloudv1.Service) (*ibmcloud.Info, error) {
+			GetIBMCloudInfo: func(logt logr.Logger, _ client.Client, instance *ibmcloudv1.Service, _ bool) (*ibmcloud.Info, error) {
 				return &ibmcloud.Info{
 					ServiceClassType: "CF",
 				}, nil
@@ -758,6 +770,11 @@ func TestServiceEnsureCFServiceExists(t *testing.T) {
 					InstanceID:   "guid",
 					DashboardURL: "https://cloud.ibm.com/services/service-name/guid",
 					ServiceClass: "service-name",
+					Conditions: []ibmcloudv1.Condition{
+						{Type: ibmcloudv1.ConditionReady, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime, Reason: "State", Message: "state"},
+						{Type: ibmcloudv1.ConditionProvisioned, Status: corev1.ConditionTrue, LastTransitionTime: testConditionTime, Reason: "Provisioned"},
+						{Type: ibmcloudv1.ConditionParametersApplied, Status: corev1.ConditionTrue, LastTransitionTime: testConditionTime, Reason: "Applied"},
+					},
 				},
 				Spec: ibmcloudv1.ServiceSpec{Plan: "Lite", ServiceClass: "service-name"},
 			}, r.Client.(MockClient).LastStatusUpdate())
@@ -785,6 +802,11 @@ func TestServiceEnsureCFServiceExists(t *testing.T) {
 					Message:      "failed",
 					Plan:         "Lite",
 					ServiceClass: "service-name",
+					Conditions: []ibmcloudv1.Condition{
+						{Type: ibmcloudv1.ConditionReady, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime, Reason: "Failed", Message: "failed"},
+						{Type: ibmcloudv1.ConditionProvisioned, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime, Reason: "Failed", Message: "failed"},
+						{Type: ibmcloudv1.ConditionParametersApplied, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime, Reason: "NotProvisioned"},
+					},
 				},
 				Spec: ibmcloudv1.ServiceSpec{Plan: "Lite", ServiceClass: "service-name"},
 			}, r.Client.(MockClient).LastStatusUpdate())
@@ -815,7 +837,7 @@ func TestServiceEnsureCFServiceExists(t *testing.T) {
 			Log:    testLogger(t),
 			Scheme: scheme,
 
-			GetIBMCloudInfo: func(logt logr.Logger, _ client.Client, instance *ibmcloudv1.Service) (*ibmcloud.Info, error) {
+			GetIBMCloudInfo: func(logt logr.Logger, _ client.Client, instance *ibmcloudv1.Service, _ bool) (*ibmcloud.Info, error) {
 				return &ibmcloud.Info{
 					ServiceClassType: "CF",
 				}, nil
@@ -850,6 +872,11 @@ func TestServiceEnsureCFServiceExists(t *testing.T) {
 				Plan:         aliasPlan,
 				ServiceClass: "service-name",
 				InstanceID:   "guid",
+				Conditions: []ibmcloudv1.Condition{
+					{Type: ibmcloudv1.ConditionReady, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime, Reason: "State", Message: "state"},
+					{Type: ibmcloudv1.ConditionProvisioned, Status: corev1.ConditionTrue, LastTransitionTime: testConditionTime, Reason: "Provisioned"},
+					{Type: ibmcloudv1.ConditionParametersApplied, Status: corev1.ConditionTrue, LastTransitionTime: testConditionTime, Reason: "Applied"},
+				},
 			},
 			Spec: ibmcloudv1.ServiceSpec{
 				Plan:         aliasPlan,
@@ -883,7 +910,7 @@ func TestServiceEnsureCFServiceExists(t *testing.T) {
 			Log:    testLogger(t),
 			Scheme: scheme,
 
-			GetIBMCloudInfo: func(logt logr.Logger, _ client.Client, instance *ibmcloudv1.Service) (*ibmcloud.Info, error) {
+			GetIBMCloudInfo: func(logt logr.Logger, _ client.Client, instance *ibmcloudv1.Service, _ bool) (*ibmcloud.Info, error) {
 				return &ibmcloud.Info{
 					ServiceClassType: "CF",
 				}, nil
@@ -917,6 +944,11 @@ func TestServiceEnsureCFServiceExists(t *testing.T) {
 					ServiceClass: "service-name",
 					InstanceID:   "guid",
 					DashboardURL: "https://cloud.ibm.com/services/service-name/guid",
+					Conditions: []ibmcloudv1.Condition{
+						{Type: ibmcloudv1.ConditionReady, Status: corev1.ConditionTrue, LastTransitionTime: testConditionTime, Reason: "Online", Message: "Online"},
+						{Type: ibmcloudv1.ConditionProvisioned, Status: corev1.ConditionTrue, LastTransitionTime: testConditionTime, Reason: "Provisioned"},
+						{Type: ibmcloudv1.ConditionParametersApplied, Status: corev1.ConditionTrue, LastTransitionTime: testConditionTime, Reason: "Applied"},
+					},
 				},
 				Spec: ibmcloudv1.ServiceSpec{
 					Plan:         aliasPlan,
@@ -948,6 +980,11 @@ func TestServiceEnsureCFServiceExists(t *testing.T) {
 					Plan:         aliasPlan,
 					ServiceClass: "service-name",
 					InstanceID:   "",
+					Conditions: []ibmcloudv1.Condition{
+						{Type: ibmcloudv1.ConditionReady, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime, Reason: "Failed", Message: "failed"},
+						{Type: ibmcloudv1.ConditionProvisioned, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime, Reason: "Failed", Message: "failed"},
+						{Type: ibmcloudv1.ConditionParametersApplied, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime, Reason: "NotProvisioned"},
+					},
 				},
 				Spec: ibmcloudv1.ServiceSpec{
 					Plan:         aliasPlan,
@@ -981,7 +1018,7 @@ func TestServiceEnsureCFServiceExists(t *testing.T) {
 			Log:    testLogger(t),
 			Scheme: scheme,
 
-			GetIBMCloudInfo: func(logt logr.Logger, _ client.Client, instance *ibmcloudv1.Service) (*ibmcloud.Info, error) {
+			GetIBMCloudInfo: func(logt logr.Logger, _ client.Client, instance *ibmcloudv1.Service, _ bool) (*ibmcloud.Info, error) {
 				return &ibmcloud.Info{
 					ServiceClassType: "CF",
 				}, nil
@@ -1016,6 +1053,11 @@ func TestServiceEnsureCFServiceExists(t *testing.T) {
 				InstanceID:   "guid",
 				ServiceClass: "service-name",
 				DashboardURL: "https://cloud.ibm.com/services/service-name/guid",
+				Conditions: []ibmcloudv1.Condition{
+					{Type: ibmcloudv1.ConditionReady, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime, Reason: "State", Message: "state"},
+					{Type: ibmcloudv1.ConditionProvisioned, Status: corev1.ConditionTrue, LastTransitionTime: testConditionTime, Reason: "Provisioned"},
+					{Type: ibmcloudv1.ConditionParametersApplied, Status: corev1.ConditionTrue, LastTransitionTime: testConditionTime, Reason: "Applied"},
+				},
 			},
 			Spec: ibmcloudv1.ServiceSpec{Plan: "Lite", ServiceClass: "service-name"},
 		}, r.Client.(MockClient).LastStatusUpdate())
@@ -1045,7 +1087,7 @@ func TestServiceEnsureCFServiceExists(t *testing.T) {
 			Log:    testLogger(t),
 			Scheme: scheme,
 
-			GetIBMCloudInfo: func(logt logr.Logger, _ client.Client, instance *ibmcloudv1.Service) (*ibmcloud.Info, error) {
+			GetIBMCloudInfo: func(logt logr.Logger, _ client.Client, instance *ibmcloudv1.Service, _ bool) (*ibmcloud.Info, error) {
 				return &ibmcloud.Info{
 					ServiceClassType: "CF",
 				}, nil
@@ -1079,6 +1121,11 @@ func TestServiceEnsureCFServiceExists(t *testing.T) {
 				Plan:         "Lite",
 				InstanceID:   "guid",
 				ServiceClass: "service-name",
+				Conditions: []ibmcloudv1.Condition{
+					{Type: ibmcloudv1.ConditionReady, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime, Reason: "Failed", Message: "failed"},
+					{Type: ibmcloudv1.ConditionProvisioned, Status: corev1.ConditionTrue, LastTransitionTime: testConditionTime, Reason: "Provisioned"},
+					{Type: ibmcloudv1.ConditionParametersApplied, Status: corev1.ConditionTrue, LastTransitionTime: testConditionTime, Reason: "Applied"},
+				},
 			},
 			Spec: ibmcloudv1.ServiceSpec{Plan: "Lite", ServiceClass: "service-name"},
 		}, r.Client.(MockClient).LastStatusUpdate())
@@ -1108,7 +1155,7 @@ func TestServiceEnsureCFServiceExists(t *testing.T) {
 			Log:    testLogger(t),
 			Scheme: scheme,
 
-			GetIBMCloudInfo: func(logt logr.Logger, _ client.Client, instance *ibmcloudv1.Service) (*ibmcloud.Info, error) {
+			GetIBMCloudInfo: func(logt logr.Logger, _ client.Client, instance *ibmcloudv1.Service, _ bool) (*ibmcloud.Info, error) {
 				return &ibmcloud.Info{
 					ServiceClassType: "CF",
 				}, nil
@@ -1139,6 +1186,11 @@ func TestServiceEnsureCFServiceExists(t *testing.T) {
 				Plan:         "Lite",
 				InstanceID:   "guid",
 				ServiceClass: "service-name",
+				Conditions: []ibmcloudv1.Condition{
+					{Type: ibmcloudv1.ConditionReady, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime, Reason: "Failed", Message: "failed"},
+					{Type: ibmcloudv1.ConditionProvisioned, Status: corev1.ConditionTrue, LastTransitionTime: testConditionTime, Reason: "Provisioned"},
+					{Type: ibmcloudv1.ConditionParametersApplied, Status: corev1.ConditionTrue, LastTransitionTime: testConditionTime, Reason: "Applied"},
+				},
 			},
 			Spec: ibmcloudv1.ServiceSpec{Plan: "Lite", ServiceClass: "service-name"},
 		}, r.Client.(MockClient).LastStatusUpdate())
@@ -1168,7 +1220,7 @@ func TestServiceEnsureCFServiceExists(t *testing.T) {
 			Log:    testLogger(t),
 			Scheme: scheme,
 
-			GetIBMCloudInfo: func(logt logr.Logger, _ client.Client, instance *ibmcloudv1.Service) (*ibmcloud.Info, error) {
+			GetIBMCloudInfo: func(logt logr.Logger, _ client.Client, instance *ibmcloudv1.Service, _ bool) (*ibmcloud.Info, error) {
 				return &ibmcloud.Info{
 					ServiceClassType: "CF",
 				}, nil
@@ -1199,6 +1251,11 @@ func TestServiceEnsureCFServiceExists(t *testing.T) {
 				Plan:         aliasPlan,
 				ServiceClass: "service-name",
 				InstanceID:   "", // instance ID should be deleted
+				Conditions: []ibmcloudv1.Condition{
+					{Type: ibmcloudv1.ConditionReady, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime, Reason: "Pending", Message: "failed"},
+					{Type: ibmcloudv1.ConditionProvisioned, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime, Reason: "Pending", Message: "failed"},
+					{Type: ibmcloudv1.ConditionParametersApplied, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime, Reason: "NotProvisioned"},
+				},
 			},
 			Spec: ibmcloudv1.ServiceSpec{
 				Plan:         aliasPlan,
@@ -1240,7 +1297,7 @@ func TestServiceEnsureResourceServiceInstance(t *testing.T) {
 				Log:    testLogger(t),
 				Scheme: scheme,
 
-				GetIBMCloudInfo: func(logt logr.Logger, _ client.Client, instance *ibmcloudv1.Service) (*ibmcloud.Info, error) {
+				GetIBMCloudInfo: func(logt logr.Logger, _ client.Client, instance *ibmcloudv1.Service, _ bool) (*ibmcloud.Info, error) {
 					return &ibmcloud.Info{}, nil
 				},
 				GetResourceServiceAliasInstance: func(session *session.Session, instanceID, resourceGroupID, servicePlanID, externalName string, logt logr.Logger) (id string, state string, err error) {
@@ -1270,6 +1327,11 @@ func TestServiceEnsureResourceServiceInstance(t *testing.T) {
 					ServiceClass: "service-name",
 					InstanceID:   "guid",
 					DashboardURL: "https://cloud.ibm.com/services/service-name/guid",
+					Conditions: []ibmcloudv1.Condition{
+						{Type: ibmcloudv1.ConditionReady, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime, Reason: "State", Message: "state"},
+						{Type: ibmcloudv1.ConditionProvisioned, Status: corev1.ConditionTrue, LastTransitionTime: testConditionTime, Reason: "Provisioned"},
+						{Type: ibmcloudv1.ConditionParametersApplied, Status: corev1.ConditionTrue, LastTransitionTime: testConditionTime, Reason: "Applied"},
+					},
 				},
 				Spec: ibmcloudv1.ServiceSpec{
 					Plan:         aliasPlan,
@@ -1287,7 +1349,7 @@ func TestServiceEnsureResourceServiceInstance(t *testing.T) {
 				Log:    testLogger(t),
 				Scheme: scheme,
 
-				GetIBMCloudInfo: func(logt logr.Logger, _ client.Client, instance *ibmcloudv1.Service) (*ibmcloud.Info, error) {
+				GetIBMCloudInfo: func(logt logr.Logger, _ client.Client, instance *ibmcloudv1.Service, _ bool) (*ibmcloud.Info, error) {
 					return &ibmcloud.Info{}, nil
 				},
 				GetResourceServiceAliasInstance: func(session *session.Session, instanceID, resourceGroupID, servicePlanID, externalName string, logt logr.Logger) (id string, state string, err error) {
@@ -1318,6 +1380,11 @@ func TestServiceEnsureResourceServiceInstance(t *testing.T) {
 					Message:      "no service instances with name myservice found for alias plan: failed",
 					Plan:         aliasPlan,
 					ServiceClass: "service-name",
+					Conditions: []ibmcloudv1.Condition{
+						{Type: ibmcloudv1.ConditionReady, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime, Reason: "Failed", Message: "no service instances with name myservice found for alias plan: failed"},
+						{Type: ibmcloudv1.ConditionProvisioned, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime, Reason: "Failed", Message: "no service instances with name myservice found for alias plan: failed"},
+						{Type: ibmcloudv1.ConditionParametersApplied, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime, Reason: "NotProvisioned"},
+					},
 				},
 				Spec: ibmcloudv1.ServiceSpec{
 					Plan:         aliasPlan,
@@ -1335,7 +1402,7 @@ func TestServiceEnsureResourceServiceInstance(t *testing.T) {
 				Log:    testLogger(t),
 				Scheme: scheme,
 
-				GetIBMCloudInfo: func(logt logr.Logger, _ client.Client, instance *ibmcloudv1.Service) (*ibmcloud.Info, error) {
+				GetIBMCloudInfo: func(logt logr.Logger, _ client.Client, instance *ibmcloudv1.Service, _ bool) (*ibmcloud.Info, error) {
 					return &ibmcloud.Info{}, nil
 				},
 				GetResourceServiceAliasInstance: func(session *session.Session, instanceID, resourceGroupID, servicePlanID, externalName string, logt logr.Logger) (id string, state string, err error) {
@@ -1366,6 +1433,11 @@ func TestServiceEnsureResourceServiceInstance(t *testing.T) {
 					Message:      "failed to resolve Alias plan instance myservice: failed",
 					Plan:         aliasPlan,
 					ServiceClass: "service-name",
+					Conditions: []ibmcloudv1.Condition{
+						{Type: ibmcloudv1.ConditionReady, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime, Reason: "Failed", Message: "failed to resolve Alias plan instance myservice: failed"},
+						{Type: ibmcloudv1.ConditionProvisioned, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime, Reason: "Failed", Message: "failed to resolve Alias plan instance myservice: failed"},
+						{Type: ibmcloudv1.ConditionParametersApplied, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime, Reason: "NotProvisioned"},
+					},
 				},
 				Spec: ibmcloudv1.ServiceSpec{
 					Plan:         aliasPlan,
@@ -1400,7 +1472,7 @@ func TestServiceEnsureResourceServiceInstance(t *testing.T) {
 				Log:    testLogger(t),
 				Scheme: scheme,
 
-				GetIBMCloudInfo: func(logt logr.Logger, _ client.Client, instance *ibmcloudv1.Service) (*ibmcloud.Info, error) {
+				GetIBMCloudInfo: func(logt logr.Logger, _ client.Client, instance *ibmcloudv1.Service, _ bool) (*ibmcloud.Info, error) {
 					return &ibmcloud.Info{}, nil
 				},
 				CreateResourceServiceInstance: func(session *session.Session, externalName, servicePlanID, resourceGroupID, targetCrn string, params map[string]interface{}, tags []string) (id string, state string, err error) {
@@ -1430,6 +1502,11 @@ func TestServiceEnsureResourceServiceInstance(t *testing.T) {
 					ServiceClass: "service-name",
 					InstanceID:   "id",
 					DashboardURL: "https://cloud.ibm.com/services/service-name/id",
+					Conditions: []ibmcloudv1.Condition{
+						{Type: ibmcloudv1.ConditionReady, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime, Reason: "State", Message: "state"},
+						{Type: ibmcloudv1.ConditionProvisioned, Status: corev1.ConditionTrue, LastTransitionTime: testConditionTime, Reason: "Provisioned"},
+						{Type: ibmcloudv1.ConditionParametersApplied, Status: corev1.ConditionTrue, LastTransitionTime: testConditionTime, Reason: "Applied"},
+					},
 				},
 				Spec: ibmcloudv1.ServiceSpec{
 					Plan:         "Lite",
@@ -1447,7 +1524,7 @@ func TestServiceEnsureResourceServiceInstance(t *testing.T) {
 				Log:    testLogger(t),
 				Scheme: scheme,
 
-				GetIBMCloudInfo: func(logt logr.Logger, _ client.Client, instance *ibmcloudv1.Service) (*ibmcloud.Info, error) {
+				GetIBMCloudInfo: func(logt logr.Logger, _ client.Client, instance *ibmcloudv1.Service, _ bool) (*ibmcloud.Info, error) {
 					return &ibmcloud.Info{}, nil
 				},
 			}
@@ -1470,6 +1547,11 @@ func TestServiceEnsureResourceServiceInstance(t *testing.T) {
 					Plan:         "Lite",
 					ServiceClass: "service-name",
 					InstanceID:   inProgress,
+					Conditions: []ibmcloudv1.Condition{
+						{Type: ibmcloudv1.ConditionReady, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime, Reason: "Unknown"},
+						{Type: ibmcloudv1.ConditionProvisioned, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime, Reason: "Provisioning"},
+						{Type: ibmcloudv1.ConditionParametersApplied, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime, Reason: "NotProvisioned"},
+					},
 				},
 				Spec: ibmcloudv1.ServiceSpec{
 					Plan:         "Lite",
@@ -1487,7 +1569,7 @@ func TestServiceEnsureResourceServiceInstance(t *testing.T) {
 				Log:    testLogger(t),
 				Scheme: scheme,
 
-				GetIBMCloudInfo: func(logt logr.Logger, _ client.Client, instance *ibmcloudv1.Service) (*ibmcloud.Info, error) {
+				GetIBMCloudInfo: func(logt logr.Logger, _ client.Client, instance *ibmcloudv1.Service, _ bool) (*ibmcloud.Info, error) {
 					return &ibmcloud.Info{}, nil
 				},
 				CreateResourceServiceInstance: func(session *session.Session, externalName, servicePlanID, resourceGroupID, targetCrn string, params map[string]interface{}, tags []string) (id string, state string, err error) {
@@ -1516,6 +1598,11 @@ func TestServiceEnsureResourceServiceInstance(t *testing.T) {
 					Plan:         "Lite",
 					ServiceClass: "service-name",
 					InstanceID:   inProgress,
+					Conditions: []ibmcloudv1.Condition{
+						{Type: ibmcloudv1.ConditionReady, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime, Reason: "Failed", Message: "failed"},
+						{Type: ibmcloudv1.ConditionProvisioned, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime, Reason: "Provisioning"},
+						{Type: ibmcloudv1.ConditionParametersApplied, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime, Reason: "NotProvisioned"},
+					},
 				},
 				Spec: ibmcloudv1.ServiceSpec{
 					Plan:         "Lite",
@@ -1524,6 +1611,194 @@ func TestServiceEnsureResourceServiceInstance(t *testing.T) {
 			}, r.Client.(MockClient).LastStatusUpdate())
 		})
 	})
+
+	t.Run("adopt", func(t *testing.T) {
+		createdAt := metav1.NewTime(testConditionTime.Add(-24 * time.Hour))
+		for _, tc := range []struct {
+			description   string
+			serviceClass  string
+			servicePlanID string
+			otherServices []runtime.Object
+			expectState   string
+			expectMessage string
+		}{
+			{
+				description:   "success",
+				serviceClass:  "service-name",
+				servicePlanID: "lite-plan-id",
+				expectState:   serviceStateOnline,
+				expectMessage: serviceStateOnline,
+			},
+			{
+				description:   "service class mismatch",
+				serviceClass:  "other-service",
+				servicePlanID: "lite-plan-id",
+				expectState:   serviceStateFailed,
+				expectMessage: "failed to adopt service instance myinstanceid: instance has service class other-service, expected service-name",
+			},
+			{
+				description:   "plan mismatch",
+				serviceClass:  "service-name",
+				servicePlanID: "other-plan-id",
+				expectState:   serviceStateFailed,
+				expectMessage: "failed to adopt service instance myinstanceid: instance plan does not match plan Lite",
+			},
+			{
+				description:   "instance used by another service",
+				serviceClass:  "service-name",
+				servicePlanID: "lite-plan-id",
+				otherServices: []runtime.Object{
+					&ibmcloudv1.Service{
+						ObjectMeta: metav1.ObjectMeta{Name: serviceName, Namespace: "othernamespace"},
+						Status:     ibmcloudv1.ServiceStatus{InstanceID: "myinstanceid"},
+					},
+				},
+				expectState:   serviceStateFailed,
+				expectMessage: "failed to adopt service instance myinstanceid: instance is already used by Service othernamespace/myservice",
+			},
+			{
+				description:   "instance being adopted by an older service",
+				serviceClass:  "service-name",
+				servicePlanID: "lite-plan-id",
+				otherServices: []runtime.Object{
+					&ibmcloudv1.Service{
+						ObjectMeta: metav1.ObjectMeta{Name: "otherservice", Namespace: namespace, CreationTimestamp: metav1.NewTime(createdAt.Add(-time.Hour))},
+						Spec:       ibmcloudv1.ServiceSpec{AdoptInstanceID: "myinstanceid"},
+					},
+				},
+				expectState:   serviceStateFailed,
+				expectMessage: "failed to adopt service instance myinstanceid: instance is being adopted by Service mynamespace/otherservice, which was created first",
+			},
+			{
+				description:   "instance being adopted by a newer service",
+				serviceClass:  "service-name",
+				servicePlanID: "lite-plan-id",
+				otherServices: []runtime.Object{
+					&ibmcloudv1.Service{
+						ObjectMeta: metav1.ObjectMeta{Name: "otherservice", Namespace: namespace, CreationTimestamp: metav1.NewTime(createdAt.Add(time.Hour))},
+						Spec:       ibmcloudv1.ServiceSpec{AdoptInstanceID: "myinstanceid"},
+					},
+				},
+				expectState:   serviceStateOnline,
+				expectMessage: serviceStateOnline,
+			},
+			{
+				description:   "instance used by an alias service",
+				serviceClass:  "service-name",
+				servicePlanID: "lite-plan-id",
+				otherServices: []runtime.Object{
+					&ibmcloudv1.Service{
+						ObjectMeta: metav1.ObjectMeta{Name: "aliasservice", Namespace: namespace},
+						Spec:       ibmcloudv1.ServiceSpec{Plan: aliasPlan},
+						Status:     ibmcloudv1.ServiceStatus{InstanceID: "myinstanceid"},
+					},
+				},
+				expectState:   serviceStateOnline,
+				expectMessage: serviceStateOnline,
+			},
+		} {
+			t.Run(tc.description, func(t *testing.T) {
+				scheme := schemas(t)
+				objects := append([]runtime.Object{
+					&ibmcloudv1.Service{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:              serviceName,
+							Namespace:         namespace,
+							CreationTimestamp: createdAt,
+						},
+						Status: ibmcloudv1.ServiceStatus{
+							Plan:         "Lite",
+							ServiceClass: "service-name",
+						},
+						Spec: ibmcloudv1.ServiceSpec{
+							Plan:            "Lite",
+							ServiceClass:    "service-name",
+							Tags:            []string{"mytag"},
+							AdoptInstanceID: "myinstanceid",
+						},
+					},
+				}, tc.otherServices...)
+				var updatedTags []string
+				r := &ServiceReconciler{
+					Client: newMockClient(
+						fake.NewFakeClientWithScheme(scheme, objects...),
+						MockConfig{},
+					),
+					Log:    testLogger(t),
+					Scheme: scheme,
+
+					GetIBMCloudInfo: func(logt logr.Logger, _ client.Client, instance *ibmcloudv1.Service, _ bool) (*ibmcloud.Info, error) {
+						return &ibmcloud.Info{ServicePlanID: "lite-plan-id"}, nil
+					},
+					GetResourceServiceInstance: func(session *session.Session, instanceID string) (serviceClass, servicePlanID, state string, err error) {
+						assert.Equal(t, "myinstanceid", instanceID)
+						return tc.serviceClass, tc.servicePlanID, "active", nil
+					},
+					UpdateResourceServiceInstance: func(session *session.Session, serviceInstanceID, externalName, servicePlanID string, params map[string]interface{}, tags []string) (state string, err error) {
+						assert.Equal(t, "myinstanceid", serviceInstanceID)
+						updatedTags = tags
+						return "active", nil
+					},
+					CreateResourceServiceInstance: func(session *session.Session, externalName, servicePlanID, resourceGroupID, targetCrn string, params map[string]interface{}, tags []string) (id, state string, err error) {
+						panic("Must not create an adopted service")
+					},
+				}
+				result, err := r.Reconcile(ctrl.Request{
+					NamespacedName: types.NamespacedName{Name: serviceName, Namespace: namespace},
+				})
+
+				assert.Equal(t, ctrl.Result{
+					Requeue:      true,
+					RequeueAfter: config.Get().SyncPeriod,
+				}, result)
+				assert.NoError(t, err)
+				status := r.Client.(MockClient).LastStatusUpdate().(*ibmcloudv1.Service).Status
+				assert.Equal(t, tc.expectState, status.State)
+				assert.Equal(t, tc.expectMessage, status.Message)
+				if tc.expectState == serviceStateOnline {
+					assert.Equal(t, "myinstanceid", status.InstanceID)
+					assert.Equal(t, []string{"mytag"}, updatedTags)
+				} else {
+					assert.Empty(t, status.InstanceID)
+					assert.Nil(t, updatedTags)
+				}
+			})
+		}
+
+		t.Run("instance ID annotation does not adopt", func(t *testing.T) {
+			scheme := schemas(t)
+			r := &ServiceReconciler{
+				Client: newMockClient(
+					fake.NewFakeClientWithScheme(scheme, &ibmcloudv1.Service{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:        serviceName,
+							Namespace:   namespace,
+							Annotations: map[string]string{instanceIDKey: "myinstanceid"},
+						},
+						Spec: ibmcloudv1.ServiceSpec{Plan: "Lite", ServiceClass: "service-name"},
+					}),
+					MockConfig{},
+				),
+				Log:    testLogger(t),
+				Scheme: scheme,
+
+				GetIBMCloudInfo: func(logt logr.Logger, _ client.Client, instance *ibmcloudv1.Service, _ bool) (*ibmcloud.Info, error) {
+					return &ibmcloud.Info{ServicePlanID: "lite-plan-id"}, nil
+				},
+				GetResourceServiceInstance: func(session *session.Session, instanceID string) (serviceClass, servicePlanID, state string, err error) {
+					panic("Must not look up the annotated instance")
+				},
+				CreateResourceServiceInstance: func(session *session.Session, externalName, servicePlanID, resourceGroupID, targetCrn string, params map[string]interface{}, tags []string) (id, state string, err error) {
+					return "newinstanceid", "active", nil
+				},
+			}
+			_, err := r.Reconcile(ctrl.Request{
+				NamespacedName: types.NamespacedName{Name: serviceName, Namespace: namespace},
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, "newinstanceid", r.Client.(MockClient).LastStatusUpdate().(*ibmcloudv1.Service).Status.InstanceID)
+		})
+	})
 }
 
 func TestServiceVerifyExists(t *testing.T) {
@@ -1571,7 +1846,7 @@ func TestServiceVerifyExists(t *testing.T) {
 			Log:    testLogger(t),
 			Scheme: scheme,
 
-			GetIBMCloudInfo: func(logt logr.Logger, _ client.Client, instance *ibmcloudv1.Service) (*ibmcloud.Info, error) {
+			GetIBMCloudInfo: func(logt logr.Logger, _ client.Client, instance *ibmcloudv1.Service, _ bool) (*ibmcloud.Info, error) {
 				return &ibmcloud.Info{}, nil
 			},
 			GetResourceServiceInstanceState: func(session *session.Session, resourceGroupID, servicePlanID, externalName, instanceID string) (state string, err error) {
@@ -1601,6 +1876,11 @@ func TestServiceVerifyExists(t *testing.T) {
 				ServiceClass: "service-name",
 				InstanceID:   "myinstanceid",
 				DashboardURL: "https://cloud.ibm.com/services/service-name/myinstanceid",
+				Conditions: []ibmcloudv1.Condition{
+					{Type: ibmcloudv1.ConditionReady, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime, Reason: "State", Message: "state"},
+					{Type: ibmcloudv1.ConditionProvisioned, Status: corev1.ConditionTrue, LastTransitionTime: testConditionTime, Reason: "Provisioned"},
+					{Type: ibmcloudv1.ConditionParametersApplied, Status: corev1.ConditionTrue, LastTransitionTime: testConditionTime, Reason: "Applied"},
+				},
 			},
 			Spec: ibmcloudv1.ServiceSpec{
 				Plan:         "Lite",
@@ -1619,7 +1899,7 @@ func TestServiceVerifyExists(t *testing.T) {
 			Log:    testLogger(t),
 			Scheme: scheme,
 
-			GetIBMCloudInfo: func(logt logr.Logger, _ client.Client, instance *ibmcloudv1.Service) (*ibmcloud.Info, error) {
+			GetIBMCloudInfo: func(logt logr.Logger, _ client.Client, instance *ibmcloudv1.Service, _ bool) (*ibmcloud.Info, error) {
 				return &ibmcloud.Info{}, nil
 			},
 			GetResourceServiceInstanceState: func(session *session.Session, resourceGroupID, servicePlanID, externalName, instanceID string) (state string, err error) {
@@ -1654,6 +1934,11 @@ func TestServiceVerifyExists(t *testing.T) {
 					ServiceClass: "service-name",
 					InstanceID:   "id",
 					DashboardURL: "https://cloud.ibm.com/services/service-name/id",
+					Conditions: []ibmcloudv1.Condition{
+						{Type: ibmcloudv1.ConditionReady, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime, Reason: "State", Message: "state"},
+						{Type: ibmcloudv1.ConditionProvisioned, Status: corev1.ConditionTrue, LastTransitionTime: testConditionTime, Reason: "Provisioned"},
+						{Type: ibmcloudv1.ConditionParametersApplied, Status: corev1.ConditionTrue, LastTransitionTime: testConditionTime, Reason: "Applied"},
+					},
 				},
 				Spec: ibmcloudv1.ServiceSpec{
 					Plan:         "Lite",
@@ -1685,6 +1970,11 @@ func TestServiceVerifyExists(t *testing.T) {
 					Plan:         "Lite",
 					ServiceClass: "service-name",
 					InstanceID:   inProgress,
+					Conditions: []ibmcloudv1.Condition{
+						{Type: ibmcloudv1.ConditionReady, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime, Reason: "Failed", Message: "failed"},
+						{Type: ibmcloudv1.ConditionProvisioned, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime, Reason: "Provisioning"},
+						{Type: ibmcloudv1.ConditionParametersApplied, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime, Reason: "NotProvisioned"},
+					},
 				},
 				Spec: ibmcloudv1.ServiceSpec{
 					Plan:         "Lite",
@@ -1703,7 +1993,7 @@ func TestServiceVerifyExists(t *testing.T) {
 			Log:    testLogger(t),
 			Scheme: scheme,
 
-			GetIBMCloudInfo: func(logt logr.Logger, _ client.Client, instance *ibmcloudv1.Service) (*ibmcloud.Info, error) {
+			GetIBMCloudInfo: func(logt logr.Logger, _ client.Client, instance *ibmcloudv1.Service, _ bool) (*ibmcloud.Info, error) {
 				return &ibmcloud.Info{}, nil
 			},
 			GetResourceServiceInstanceState: func(session *session.Session, resourceGroupID, servicePlanID, externalName, instanceID string) (state string, err error) {
@@ -1729,6 +2019,11 @@ func TestServiceVerifyExists(t *testing.T) {
 				Plan:         "Lite",
 				ServiceClass: "service-name",
 				InstanceID:   inProgress,
+				Conditions: []ibmcloudv1.Condition{
+					{Type: ibmcloudv1.ConditionReady, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime, Reason: "Unknown"},
+					{Type: ibmcloudv1.ConditionProvisioned, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime, Reason: "Provisioning"},
+					{Type: ibmcloudv1.ConditionParametersApplied, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime, Reason: "NotProvisioned"},
+				},
 			},
 			Spec: ibmcloudv1.ServiceSpec{
 				Plan:         "Lite",
@@ -1746,7 +2041,7 @@ func TestServiceVerifyExists(t *testing.T) {
 			Log:    testLogger(t),
 			Scheme: scheme,
 
-			GetIBMCloudInfo: func(logt logr.Logger, _ client.Client, instance *ibmcloudv1.Service) (*ibmcloud.Info, error) {
+			GetIBMCloudInfo: func(logt logr.Logger, _ client.Client, instance *ibmcloudv1.Service, _ bool) (*ibmcloud.Info, error) {
 				return &ibmcloud.Info{}, nil
 			},
 			GetResourceServiceInstanceState: func(session *session.Session, resourceGroupID, servicePlanID, externalName, instanceID string) (state string, err error) {
@@ -1778,6 +2073,11 @@ func TestServiceVerifyExists(t *testing.T) {
 				Plan:         aliasPlan,
 				ServiceClass: "service-name",
 				InstanceID:   "",
+				Conditions: []ibmcloudv1.Condition{
+					{Type: ibmcloudv1.ConditionReady, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime, Reason: "Pending", Message: "aliased service instance no longer exists"},
+					{Type: ibmcloudv1.ConditionProvisioned, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime, Reason: "Pending", Message: "aliased service instance no longer exists"},
+					{Type: ibmcloudv1.ConditionParametersApplied, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime, Reason: "NotProvisioned"},
+				},
 			},
 			Spec: ibmcloudv1.ServiceSpec{
 				Plan:         aliasPlan,
@@ -1795,7 +2095,7 @@ func TestServiceVerifyExists(t *testing.T) {
 			Log:    testLogger(t),
 			Scheme: scheme,
 
-			GetIBMCloudInfo: func(logt logr.Logger, _ client.Client, instance *ibmcloudv1.Service) (*ibmcloud.Info, error) {
+			GetIBMCloudInfo: func(logt logr.Logger, _ client.Client, instance *ibmcloudv1.Service, _ bool) (*ibmcloud.Info, error) {
 				return &ibmcloud.Info{}, nil
 			},
 			GetResourceServiceInstanceState: func(session *session.Session, resourceGroupID, servicePlanID, externalName, instanceID string) (state string, err error) {
@@ -1827,6 +2127,11 @@ func TestServiceVerifyExists(t *testing.T) {
 				Plan:         aliasPlan,
 				ServiceClass: "service-name",
 				InstanceID:   "myinstanceid",
+				Conditions: []ibmcloudv1.Condition{
+					{Type: ibmcloudv1.ConditionReady, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime, Reason: "Pending", Message: "failed"},
+					{Type: ibmcloudv1.ConditionProvisioned, Status: corev1.ConditionTrue, LastTransitionTime: testConditionTime, Reason: "Provisioned"},
+					{Type: ibmcloudv1.ConditionParametersApplied, Status: corev1.ConditionTrue, LastTransitionTime: testConditionTime, Reason: "Applied"},
+				},
 			},
 			Spec: ibmcloudv1.ServiceSpec{
 				Plan:         aliasPlan,
@@ -1844,7 +2149,7 @@ func TestServiceVerifyExists(t *testing.T) {
 			Log:    testLogger(t),
 			Scheme: scheme,
 
-			GetIBMCloudInfo: func(logt logr.Logger, _ client.Client, instance *ibmcloudv1.Service) (*ibmcloud.Info, error) {
+			GetIBMCloudInfo: func(logt logr.Logger, _ client.Client, instance *ibmcloudv1.Service, _ bool) (*ibmcloud.Info, error) {
 				return &ibmcloud.Info{}, nil
 			},
 			GetResourceServiceInstanceState: func(session *session.Session, resourceGroupID, servicePlanID, externalName, instanceID string) (state string, err error) {
@@ -1873,6 +2178,11 @@ func TestServiceVerifyExists(t *testing.T) {
 				Plan:         "Lite",
 				ServiceClass: "service-name",
 				InstanceID:   "myinstanceid",
+				Conditions: []ibmcloudv1.Condition{
+					{Type: ibmcloudv1.ConditionReady, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime, Reason: "Pending", Message: "failed"},
+					{Type: ibmcloudv1.ConditionProvisioned, Status: corev1.ConditionTrue, LastTransitionTime: testConditionTime, Reason: "Provisioned"},
+					{Type: ibmcloudv1.ConditionParametersApplied, Status: corev1.ConditionTrue, LastTransitionTime: testConditionTime, Reason: "Applied"},
+				},
 			},
 			Spec: ibmcloudv1.ServiceSpec{
 				Plan:         "Lite",
@@ -1913,7 +2223,7 @@ func TestServiceUpdateTagsOrParamsFailed(t *testing.T) {
 		Log:    testLogger(t),
 		Scheme: scheme,
 
-		GetIBMCloudInfo: func(logt logr.Logger, _ client.Client, instance *ibmcloudv1.Service) (*ibmcloud.Info, error) {
+		GetIBMCloudInfo: func(logt logr.Logger, _ client.Client, instance *ibmcloudv1.Service, _ bool) (*ibmcloud.Info, error) {
 			return &ibmcloud.Info{}, nil
 		},
 		GetResourceServiceInstanceState: func(session *session.Session, resourceGroupID, servicePlanID, externalName, instanceID string) (state string, err error) {
@@ -1945,6 +2255,11 @@ func TestServiceUpdateTagsOrParamsFailed(t *testing.T) {
 			Plan:         "Lite",
 			ServiceClass: "service-name",
 			InstanceID:   "myinstanceid",
+			Conditions: []ibmcloudv1.Condition{
+				{Type: ibmcloudv1.ConditionReady, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime, Reason: "Failed", Message: "failed"},
+				{Type: ibmcloudv1.ConditionProvisioned, Status: corev1.ConditionTrue, LastTransitionTime: testConditionTime, Reason: "Provisioned"},
+				{Type: ibmcloudv1.ConditionParametersApplied, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime, Reason: "Failed", Message: "failed"},
+			},
 		},
 		Spec: ibmcloudv1.ServiceSpec{
 			Plan:         "Lite",
@@ -1954,6 +2269,431 @@ func TestServiceUpdateTagsOrParamsFailed(t *testing.T) {
 	}, r.Client.(MockClient).LastStatusUpdate())
 }
 
+func TestServiceUpdatePlan(t *testing.T) {
+	t.Parallel()
+	const (
+		namespace   = "mynamespace"
+		serviceName = "myservice"
+	)
+	for _, tc := range []struct {
+		description  string
+		updateErr    error
+		expectStatus ibmcloudv1.ServiceStatus
+	}{
+		{
+			description: "plan updated",
+			expectStatus: ibmcloudv1.ServiceStatus{
+				State:        serviceStateOnline,
+				Message:      serviceStateOnline,
+				Plan:         "standard",
+				ServiceClass: "service-name",
+				InstanceID:   "myinstanceid",
+				DashboardURL: "https://cloud.ibm.com/services/service-name/myinstanceid",
+				Conditions: []ibmcloudv1.Condition{
+					{Type: ibmcloudv1.ConditionReady, Status: corev1.ConditionTrue, LastTransitionTime: testConditionTime, Reason: "Online", Message: serviceStateOnline},
+					{Type: ibmcloudv1.ConditionProvisioned, Status: corev1.ConditionTrue, LastTransitionTime: testConditionTime, Reason: "Provisioned"},
+					{Type: ibmcloudv1.ConditionParametersApplied, Status: corev1.ConditionTrue, LastTransitionTime: testConditionTime, Reason: "Applied"},
+					{Type: ibmcloudv1.ConditionPlanUpdated, Status: corev1.ConditionTrue, LastTransitionTime: testConditionTime, Reason: "Updated"},
+				},
+			},
+		},
+		{
+			description: "plan update failed",
+			updateErr:   fmt.Errorf("failed"),
+			expectStatus: ibmcloudv1.ServiceStatus{
+				State:        serviceStateFailed,
+				Message:      "failed to change plan from Lite to standard: failed",
+				Plan:         "Lite",
+				ServiceClass: "service-name",
+				InstanceID:   "myinstanceid",
+				Conditions: []ibmcloudv1.Condition{
+					{Type: ibmcloudv1.ConditionReady, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime, Reason: "Failed", Message: "failed to change plan from Lite to standard: failed"},
+					{Type: ibmcloudv1.ConditionProvisioned, Status: corev1.ConditionTrue, LastTransitionTime: testConditionTime, Reason: "Provisioned"},
+					{Type: ibmcloudv1.ConditionParametersApplied, Status: corev1.ConditionTrue, LastTransitionTime: testConditionTime, Reason: "Applied"},
+					{Type: ibmcloudv1.ConditionPlanUpdated, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime, Reason: "Failed", Message: "failed to change plan from Lite to standard: failed"},
+				},
+			},
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			scheme := schemas(t)
+			objects := []runtime.Object{
+				&ibmcloudv1.Service{
+					ObjectMeta: metav1.ObjectMeta{Name: serviceName, Namespace: namespace},
+					Status: ibmcloudv1.ServiceStatus{
+						Plan:         "Lite",
+						ServiceClass: "service-name",
+						InstanceID:   "myinstanceid",
+					},
+					Spec: ibmcloudv1.ServiceSpec{
+						Plan:         "standard",
+						ServiceClass: "service-name",
+					},
+				},
+			}
+
+			r := &ServiceReconciler{
+				Client: newMockClient(
+					fake.NewFakeClientWithScheme(scheme, objects...),
+					MockConfig{},
+				),
+				Log:    testLogger(t),
+				Scheme: scheme,
+
+				GetIBMCloudInfo: func(logt logr.Logger, _ client.Client, instance *ibmcloudv1.Service, _ bool) (*ibmcloud.Info, error) {
+					return &ibmcloud.Info{ServicePlanID: "standard-plan-id"}, nil
+				},
+				GetResourceServiceInstanceState: func(session *session.Session, resourceGroupID, servicePlanID, externalName, instanceID string) (state string, err error) {
+					assert.Empty(t, servicePlanID, "Instance lookup should not filter on the new plan")
+					return "active", nil
+				},
+				UpdateResourceServiceInstance: func(session *session.Session, serviceInstanceID, externalName, servicePlanID string, params map[string]interface{}, tags []string) (state string, err error) {
+					assert.Equal(t, "myinstanceid", serviceInstanceID)
+					assert.Equal(t, "standard-plan-id", servicePlanID)
+					if tc.updateErr != nil {
+						return "", tc.updateErr
+					}
+					return "active", nil
+				},
+			}
+
+			result, err := r.Reconcile(ctrl.Request{
+				NamespacedName: types.NamespacedName{Name: serviceName, Namespace: namespace},
+			})
+			assert.Equal(t, ctrl.Result{
+				Requeue:      true,
+				RequeueAfter: config.Get().SyncPeriod,
+			}, result)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectStatus, r.Client.(MockClient).LastStatusUpdate().(*ibmcloudv1.Service).Status)
+		})
+	}
+}
+
+func TestServiceRename(t *testing.T) {
+	t.Parallel()
+	const (
+		namespace   = "mynamespace"
+		serviceName = "myservice"
+	)
+	scheme := schemas(t)
+	objects := []runtime.Object{
+		&ibmcloudv1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: serviceName, Namespace: namespace},
+			Status: ibmcloudv1.ServiceStatus{
+				Plan:         "Lite",
+				ServiceClass: "service-name",
+				InstanceID:   "myinstanceid",
+				ExternalName: "old-name",
+			},
+			Spec: ibmcloudv1.ServiceSpec{
+				Plan:         "Lite",
+				ServiceClass: "service-name",
+				ExternalName: "new-name",
+			},
+		},
+	}
+
+	r := &ServiceReconciler{
+		Client: newMockClient(
+			fake.NewFakeClientWithScheme(scheme, objects...),
+			MockConfig{},
+		),
+		Log:    testLogger(t),
+		Scheme: scheme,
+
+		GetIBMCloudInfo: func(logt logr.Logger, _ client.Client, instance *ibmcloudv1.Service, _ bool) (*ibmcloud.Info, error) {
+			return &ibmcloud.Info{}, nil
+		},
+		GetResourceServiceInstanceState: func(session *session.Session, resourceGroupID, servicePlanID, externalName, instanceID string) (state string, err error) {
+			assert.Equal(t, "old-name", externalName, "Instance should be found by its previous name")
+			return "active", nil
+		},
+		UpdateResourceServiceInstance: func(session *session.Session, serviceInstanceID, externalName, servicePlanID string, params map[string]interface{}, tags []string) (state string, err error) {
+			assert.Equal(t, "myinstanceid", serviceInstanceID)
+			assert.Equal(t, "new-name", externalName)
+			return "active", nil
+		},
+	}
+
+	result, err := r.Reconcile(ctrl.Request{
+		NamespacedName: types.NamespacedName{Name: serviceName, Namespace: namespace},
+	})
+	assert.Equal(t, ctrl.Result{
+		Requeue:      true,
+		RequeueAfter: config.Get().SyncPeriod,
+	}, result)
+	assert.NoError(t, err)
+	status := r.Client.(MockClient).LastStatusUpdate().(*ibmcloudv1.Service).Status
+	assert.Equal(t, serviceStateOnline, status.State)
+	assert.Equal(t, "new-name", status.ExternalName)
+	assert.Equal(t, "old-name", status.PreviousExternalName)
+}
+
+func TestServiceUpdateReferencedParams(t *testing.T) {
+	t.Parallel()
+	const (
+		namespace   = "mynamespace"
+		serviceName = "myservice"
+	)
+	params := []ibmcloudv1.Param{{
+		Name: "password",
+		ValueFrom: &ibmcloudv1.ParamSource{
+			SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "mysecret"}, Key: "password"},
+		},
+	}}
+	expectParams := map[string]interface{}{"password": "newpassword"}
+	expectHash, err := parametersHash(context.Background(), nil, expectParams)
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		description     string
+		recordedHash    string
+		updateErr       error
+		expectState     string
+		expectHash      string
+		expectCondition bool
+	}{
+		{
+			description:     "changed secret value",
+			recordedHash:    "oldhash",
+			expectState:     serviceStateOnline,
+			expectHash:      expectHash,
+			expectCondition: true,
+		},
+		{
+			description:     "missing hash after migration",
+			expectState:     serviceStateOnline,
+			expectHash:      expectHash,
+			expectCondition: true,
+		},
+		{
+			description:  "update failed",
+			recordedHash: "oldhash",
+			updateErr:    fmt.Errorf("failed"),
+			expectState:  serviceStateFailed,
+			expectHash:   "oldhash",
+		},
+	} {
+		tc := tc
+		t.Run(tc.description, func(t *testing.T) {
+			t.Parallel()
+			scheme := schemas(t)
+			objects := []runtime.Object{
+				&ibmcloudv1.Service{
+					ObjectMeta: metav1.ObjectMeta{Name: serviceName, Namespace: namespace},
+					Status: ibmcloudv1.ServiceStatus{
+						State:          serviceStateOnline,
+						Plan:           "Lite",
+						ServiceClass:   "service-name",
+						InstanceID:     "myinstanceid",
+						ParameterNames: []string{"password"},
+						ParametersHash: tc.recordedHash,
+					},
+					Spec: ibmcloudv1.ServiceSpec{
+						Plan:         "Lite",
+						ServiceClass: "service-name",
+						Parameters:   params,
+					},
+				},
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "mysecret", Namespace: namespace},
+					Data:       map[string][]byte{"password": []byte("newpassword")},
+				},
+			}
+
+			var updatedParams map[string]interface{}
+			r := &ServiceReconciler{
+				Client: newMockClient(
+					fake.NewFakeClientWithScheme(scheme, objects...),
+					MockConfig{},
+				),
+				Log:    testLogger(t),
+				Scheme: scheme,
+
+				GetIBMCloudInfo: func(logt logr.Logger, _ client.Client, instance *ibmcloudv1.Service, _ bool) (*ibmcloud.Info, error) {
+					return &ibmcloud.Info{}, nil
+				},
+				GetResourceServiceInstanceState: func(session *session.Session, resourceGroupID, servicePlanID, externalName, instanceID string) (state string, err error) {
+					return "active", nil
+				},
+				UpdateResourceServiceInstance: func(session *session.Session, serviceInstanceID, externalName, servicePlanID string, params map[string]interface{}, tags []string) (state string, err error) {
+					updatedParams = params
+					return "active", tc.updateErr
+				},
+			}
+
+			result, err := r.Reconcile(ctrl.Request{
+				NamespacedName: types.NamespacedName{Name: serviceName, Namespace: namespace},
+			})
+			assert.Equal(t, ctrl.Result{
+				Requeue:      true,
+				RequeueAfter: config.Get().SyncPeriod,
+			}, result)
+			assert.NoError(t, err)
+			assert.Equal(t, expectParams, updatedParams, "Changed secret value should be applied")
+			status := r.Client.(MockClient).LastStatusUpdate().(*ibmcloudv1.Service).Status
+			assert.Equal(t, tc.expectState, status.State)
+			assert.Equal(t, []string{"password"}, status.ParameterNames)
+			assert.Equal(t, tc.expectHash, status.ParametersHash, "Hash should only be recorded once the parameters are applied")
+			assert.Equal(t, tc.expectCondition, ibmcloudv1.IsConditionTrue(status.Conditions, ibmcloudv1.ConditionParametersApplied))
+			assert.Nil(t, status.Parameters, "Parameter values should not be stored in status")
+		})
+	}
+}
+
+func TestServiceObserve(t *testing.T) {
+	t.Parallel()
+	const (
+		namespace   = "mynamespace"
+		serviceName = "myservice"
+	)
+	spec := ibmcloudv1.ServiceSpec{
+		Plan:             "Lite",
+		ServiceClass:     "service-name",
+		ManagementPolicy: ibmcloudv1.ManagementPolicyObserve,
+	}
+
+	for _, tc := range []struct {
+		description   string
+		status        ibmcloudv1.ServiceStatus
+		plan          string
+		externalName  string
+		tags          []string
+		aliasErr      error
+		stateErr      error
+		expectState   string
+		expectID      string
+		expectMessage string
+		expectPlan    string
+	}{
+		{
+			description:   "found existing instance",
+			status:        ibmcloudv1.ServiceStatus{Plan: "Lite", ServiceClass: "service-name"},
+			expectState:   serviceStateOnline,
+			expectID:      "myinstanceid",
+			expectMessage: serviceStateOnline,
+			expectPlan:    "Lite",
+		},
+		{
+			description:   "found existing instance by spec",
+			status:        ibmcloudv1.ServiceStatus{ServiceClass: "service-name"},
+			plan:          "standard",
+			externalName:  "myexternalname",
+			expectState:   serviceStateOnline,
+			expectID:      "myinstanceid",
+			expectMessage: serviceStateOnline,
+			expectPlan:    "standard",
+		},
+		{
+			description:   "existing instance not found",
+			status:        ibmcloudv1.ServiceStatus{Plan: "Lite", ServiceClass: "service-name"},
+			aliasErr:      resource.NotFoundError{Err: fmt.Errorf("not found")},
+			expectState:   serviceStateFailed,
+			expectMessage: "no service instances with name myservice found for observe policy: not found",
+			expectPlan:    "Lite",
+		},
+		{
+			description:   "observed instance deleted",
+			status:        ibmcloudv1.ServiceStatus{State: serviceStateOnline, Plan: "Lite", ServiceClass: "service-name", InstanceID: "myinstanceid"},
+			stateErr:      resource.NotFoundError{Err: fmt.Errorf("not found")},
+			expectState:   serviceStatePending,
+			expectMessage: "observed service instance no longer exists",
+			expectPlan:    "Lite",
+		},
+		{
+			description:   "tags changed",
+			status:        ibmcloudv1.ServiceStatus{State: serviceStateOnline, Message: serviceStateOnline, Plan: "Lite", ServiceClass: "service-name", InstanceID: "myinstanceid"},
+			tags:          []string{"mytag"},
+			expectState:   serviceStateOnline,
+			expectID:      "myinstanceid",
+			expectMessage: serviceStateOnline,
+			expectPlan:    "Lite",
+		},
+		{
+			description:   "plan and name changed",
+			status:        ibmcloudv1.ServiceStatus{State: serviceStateOnline, Message: serviceStateOnline, Plan: "Lite", ServiceClass: "service-name", InstanceID: "myinstanceid"},
+			plan:          "standard",
+			externalName:  "myexternalname",
+			expectState:   serviceStateOnline,
+			expectID:      "myinstanceid",
+			expectMessage: serviceStateOnline,
+			expectPlan:    "Lite",
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			scheme := schemas(t)
+			instanceSpec := spec
+			instanceSpec.Tags = tc.tags
+			if tc.plan != "" {
+				instanceSpec.Plan = tc.plan
+			}
+			instanceSpec.ExternalName = tc.externalName
+			objects := []runtime.Object{
+				&ibmcloudv1.Service{
+					ObjectMeta: metav1.ObjectMeta{Name: serviceName, Namespace: namespace},
+					Status:     tc.status,
+					Spec:       instanceSpec,
+				},
+			}
+			r := &ServiceReconciler{
+				Client: newMockClient(
+					fake.NewFakeClientWithScheme(scheme, objects...),
+					MockConfig{},
+				),
+				Log:    testLogger(t),
+				Scheme: scheme,
+
+				GetIBMCloudInfo: func(logt logr.Logger, _ client.Client, instance *ibmcloudv1.Service, _ bool) (*ibmcloud.Info, error) {
+					return &ibmcloud.Info{}, nil
+				},
+				GetResourceServiceAliasInstance: func(session *session.Session, instanceID, resourceGroupID, servicePlanID, externalName string, logt logr.Logger) (id string, state string, err error) {
+					if tc.aliasErr != nil {
+						return "", "", tc.aliasErr
+					}
+					return "myinstanceid", "active", nil
+				},
+				GetResourceServiceInstanceState: func(session *session.Session, resourceGroupID, servicePlanID, externalName, instanceID string) (state string, err error) {
+					if tc.stateErr != nil {
+						return "", tc.stateErr
+					}
+					return "active", nil
+				},
+				CreateResourceServiceInstance: func(session *session.Session, externalName, servicePlanID, resourceGroupID, targetCrn string, params map[string]interface{}, tags []string) (id, state string, err error) {
+					panic("Must not create an observed service")
+				},
+				UpdateResourceServiceInstance: func(session *session.Session, serviceInstanceID, externalName, servicePlanID string, params map[string]interface{}, tags []string) (state string, err error) {
+					panic("Must not update an observed service")
+				},
+			}
+
+			result, err := r.Reconcile(ctrl.Request{
+				NamespacedName: types.NamespacedName{Name: serviceName, Namespace: namespace},
+			})
+			assert.Equal(t, ctrl.Result{
+				Requeue:      true,
+				RequeueAfter: config.Get().SyncPeriod,
+			}, result)
+			assert.NoError(t, err)
+			status := tc.status // unchanged if no status update was needed
+			if updated := r.Client.(MockClient).LastStatusUpdate(); updated != nil {
+				status = updated.(*ibmcloudv1.Service).Status
+			}
+			assert.Equal(t, tc.expectState, status.State)
+			assert.Equal(t, tc.expectMessage, status.Message)
+			assert.Equal(t, tc.expectID, status.InstanceID)
+			// nothing is applied to observed instances, so the status only records the plan and name they were found by
+			assert.Equal(t, tc.expectPlan, status.Plan)
+			if tc.expectPlan == tc.plan {
+				assert.Equal(t, tc.externalName, status.ExternalName)
+			} else {
+				assert.Empty(t, status.ExternalName)
+			}
+			assert.Nil(t, status.Tags)
+		})
+	}
+}
+
 func TestSpecChanged(t *testing.T) {
 	t.Parallel()
 	const (
@@ -1990,6 +2730,22 @@ func TestSpecChanged(t *testing.T) {
 					ExternalName: somethingElse,
 				},
 			},
+			expectChanged: false,
+		},
+		{
+			description: "mismatched CF external name",
+			instance: ibmcloudv1.Service{
+				Spec: ibmcloudv1.ServiceSpec{
+					Plan:             something,
+					ServiceClassType: "CF",
+					ExternalName:     something,
+				},
+				Status: ibmcloudv1.ServiceStatus{
+					Plan:             something,
+					ServiceClassType: "CF",
+					ExternalName:     somethingElse,
+				},
+			},
 			expectChanged: true,
 		},
 		{
@@ -2002,6 +2758,32 @@ func TestSpecChanged(t *testing.T) {
 					Plan: somethingElse,
 				},
 			},
+			expectChanged: false,
+		},
+		{
+			description: "mismatched CF plan",
+			instance: ibmcloudv1.Service{
+				Spec: ibmcloudv1.ServiceSpec{
+					Plan:             something,
+					ServiceClassType: "CF",
+				},
+				Status: ibmcloudv1.ServiceStatus{
+					Plan:             somethingElse,
+					ServiceClassType: "CF",
+				},
+			},
+			expectChanged: true,
+		},
+		{
+			description: "mismatched alias plan",
+			instance: ibmcloudv1.Service{
+				Spec: ibmcloudv1.ServiceSpec{
+					Plan: aliasPlan,
+				},
+				Status: ibmcloudv1.ServiceStatus{
+					Plan: somethingElse,
+				},
+			},
 			expectChanged: true,
 		},
 		{
@@ -2299,6 +3081,72 @@ func TestServiceParamValueToJSON(t *testing.T) {
 	}
 }
 
+func TestServiceParamValueToJSONStrictNamespaces(t *testing.T) {
+	t.Parallel()
+	objects := []runtime.Object{
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "mysecret", Namespace: "default"},
+			Data:       map[string][]byte{"mykey": []byte("myvalue")},
+		},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "myconfigmap", Namespace: "default"},
+			Data:       map[string]string{"mykey": "myvalue"},
+		},
+	}
+	secretRef := ibmcloudv1.ParamSource{
+		SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "mysecret"}, Key: "mykey"},
+	}
+	configMapRef := ibmcloudv1.ParamSource{
+		ConfigMapKeyRef: &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "myconfigmap"}, Key: "mykey"},
+	}
+
+	for _, tc := range []struct {
+		description string
+		strict      bool
+		valueFrom   ibmcloudv1.ParamSource
+		expectErr   string
+	}{
+		{
+			description: "secret falls back to default namespace",
+			valueFrom:   secretRef,
+		},
+		{
+			description: "configmap falls back to default namespace",
+			valueFrom:   configMapRef,
+		},
+		{
+			description: "strict secret",
+			strict:      true,
+			valueFrom:   secretRef,
+			expectErr:   "Missing secret mysecret in namespace mynamespace: strict namespaces are enabled, so namespace default was not checked",
+		},
+		{
+			description: "strict configmap",
+			strict:      true,
+			valueFrom:   configMapRef,
+			expectErr:   "Missing configmap myconfigmap in namespace mynamespace: strict namespaces are enabled, so namespace default was not checked",
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			scheme := schemas(t)
+			r := &ServiceReconciler{
+				Client:           fake.NewFakeClientWithScheme(scheme, objects...),
+				Log:              testLogger(t),
+				Scheme:           scheme,
+				StrictNamespaces: tc.strict,
+			}
+
+			j, err := r.paramValueToJSON(context.TODO(), tc.valueFrom, "mynamespace")
+			if tc.expectErr != "" {
+				assert.EqualError(t, err, tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "myvalue", j)
+		})
+	}
+}
+
 func TestServiceUpdateStatusFailed(t *testing.T) {
 	t.Parallel()
 	const (
@@ -2328,7 +3176,7 @@ func TestServiceUpdateStatusFailed(t *testing.T) {
 		Scheme: scheme,
 	}
 
-	result, err := r.updateStatus(nil, r.Log, instance, ibmcloudv1.ResourceContext{}, "myinstanceid", "state", "")
+	result, err := r.updateStatus(nil, r.Log, instance, ibmcloudv1.ResourceContext{}, "myinstanceid", "state", "", "", "")
 	assert.Equal(t, ctrl.Result{}, result)
 	assert.EqualError(t, err, "failed")
 	assert.Equal(t, &ibmcloudv1.Service{
@@ -2343,6 +3191,11 @@ func TestServiceUpdateStatusFailed(t *testing.T) {
 			ServiceClass: "service-name",
 			InstanceID:   "myinstanceid",
 			DashboardURL: "https://cloud.ibm.com/services/service-name/myinstanceid",
+			Conditions: []ibmcloudv1.Condition{
+				{Type: ibmcloudv1.ConditionReady, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime, Reason: "State", Message: "state"},
+				{Type: ibmcloudv1.ConditionProvisioned, Status: corev1.ConditionTrue, LastTransitionTime: testConditionTime, Reason: "Provisioned"},
+				{Type: ibmcloudv1.ConditionParametersApplied, Status: corev1.ConditionTrue, LastTransitionTime: testConditionTime, Reason: "Applied"},
+			},
 		},
 		Spec: ibmcloudv1.ServiceSpec{
 			Plan:         "Lite",
@@ -2381,7 +3234,7 @@ func TestServiceUpdateStatusError(t *testing.T) {
 			Scheme: scheme,
 		}
 
-		result, err := r.updateStatusError(instance, "state", fmt.Errorf("no such host"))
+		result, err := r.updateStatusError(instance, "state", "", fmt.Errorf("no such host"))
 		assert.Equal(t, ctrl.Result{
 			Requeue:      true,
 			RequeueAfter: 5 * time.Minute,
@@ -2399,7 +3252,7 @@ func TestServiceUpdateStatusError(t *testing.T) {
 			Scheme: scheme,
 		}
 
-		result, err := r.updateStatusError(instance, "state", fmt.Errorf("some error"))
+		result, err := r.updateStatusError(instance, "state", "", fmt.Errorf("some error"))
 		assert.Equal(t, ctrl.Result{}, result)
 		assert.EqualError(t, err, "failed")
 		assert.Equal(t, &ibmcloudv1.Service{
@@ -2413,6 +3266,11 @@ func TestServiceUpdateStatusError(t *testing.T) {
 				Plan:         "Lite",
 				ServiceClass: "service-name",
 				InstanceID:   "myinstanceid",
+				Conditions: []ibmcloudv1.Condition{
+					{Type: ibmcloudv1.ConditionReady, Status: corev1.ConditionFalse, LastTransitionTime: testConditionTime, Reason: "State", Message: "some error"},
+					{Type: ibmcloudv1.ConditionProvisioned, Status: corev1.ConditionTrue, LastTransitionTime: testConditionTime, Reason: "Provisioned"},
+					{Type: ibmcloudv1.ConditionParametersApplied, Status: corev1.ConditionTrue, LastTransitionTime: testConditionTime, Reason: "Applied"},
+				},
 			},
 			Spec: ibmcloudv1.ServiceSpec{
 				Plan:         "Lite",
@@ -2484,6 +3342,38 @@ func TestDeleteService(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("observe policy is a no-op", func(t *testing.T) {
+		instanceCopy := *instance
+		instanceCopy.Spec.ManagementPolicy = ibmcloudv1.ManagementPolicyObserve
+		r := &ServiceReconciler{
+			Client: newMockClient(
+				fake.NewFakeClientWithScheme(scheme, &instanceCopy),
+				MockConfig{},
+			),
+			Log:    testLogger(t),
+			Scheme: scheme,
+		}
+
+		err := r.deleteService(nil, r.Log, &instanceCopy, "")
+		assert.NoError(t, err)
+	})
+
+	t.Run("retain policy is a no-op", func(t *testing.T) {
+		instanceCopy := *instance
+		instanceCopy.Spec.DeletionPolicy = ibmcloudv1.DeletionPolicyRetain
+		r := &ServiceReconciler{
+			Client: newMockClient(
+				fake.NewFakeClientWithScheme(scheme, &instanceCopy),
+				MockConfig{},
+			),
+			Log:    testLogger(t),
+			Scheme: scheme,
+		}
+
+		err := r.deleteService(nil, r.Log, &instanceCopy, "")
+		assert.NoError(t, err)
+	})
+
 	t.Run("delete CF service", func(t *testing.T) {
 		someErr := fmt.Errorf("some error")
 		r := &ServiceReconciler{
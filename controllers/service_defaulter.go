@@ -0,0 +1,84 @@
+/*
+ * Copyright 2021 IBM Corporation
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-logr/logr"
+	ibmcloudv1 "github.com/ibm/cloud-operators/api/v1"
+	"github.com/ibm/cloud-operators/internal/ibmcloud"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+const serviceDefaulterPath = "/mutate-ibmcloud-ibm-com-v1-service"
+
+// +kubebuilder:webhook:path=/mutate-ibmcloud-ibm-com-v1-service,mutating=true,failurePolicy=fail,sideEffects=None,groups=ibmcloud.ibm.com,resources=services,verbs=create,versions=v1,name=mservice.ibmcloud.ibm.com,admissionReviewVersions=v1beta1
+
+// ServiceDefaulter fills in a new Service's context from the ibmcloud-operator-defaults ConfigMap,
+// so the stored Service records the account context it was created in
+type ServiceDefaulter struct {
+	Client client.Client
+	Log    logr.Logger
+	// StrictNamespaces disables falling back to the default namespace for the ibmcloud-operator-defaults ConfigMap
+	StrictNamespaces bool
+
+	GetDefaultContext ibmcloud.DefaultContextGetter
+
+	decoder *admission.Decoder
+}
+
+var _ admission.Handler = &ServiceDefaulter{}
+
+// Handle sets Spec.Context on Service creation
+func (d *ServiceDefaulter) Handle(ctx context.Context, req admission.Request) admission.Response {
+	instance := &ibmcloudv1.Service{}
+	if err := d.decoder.Decode(req, instance); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	lookup := instance.DeepCopy()
+	if lookup.Namespace == "" {
+		lookup.Namespace = req.Namespace
+	}
+	logt := d.Log.WithValues("service", lookup.Namespace+"/"+lookup.Name)
+
+	resourceContext, err := d.GetDefaultContext(logt, d.Client, lookup, d.StrictNamespaces)
+	if err != nil {
+		// the controller reports the missing configuration on the Service's status
+		logt.Info("Unable to resolve default context, leaving it unset", "error", err.Error())
+		return admission.Allowed("unable to resolve default context")
+	}
+	if resourceContext == instance.Spec.Context {
+		return admission.Allowed("context already set")
+	}
+	instance.Spec.Context = resourceContext
+
+	marshaled, err := json.Marshal(instance)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	return admission.PatchResponseFromRaw(req.Object.Raw, marshaled)
+}
+
+// InjectDecoder implements admission.DecoderInjector
+func (d *ServiceDefaulter) InjectDecoder(decoder *admission.Decoder) error {
+	d.decoder = decoder
+	return nil
+}
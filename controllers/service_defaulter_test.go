@@ -0,0 +1,97 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/go-logr/logr"
+	ibmcloudv1 "github.com/ibm/cloud-operators/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gomodules.xyz/jsonpatch/v2"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+func TestServiceDefaulter(t *testing.T) {
+	t.Parallel()
+	defaultContext := ibmcloudv1.ResourceContext{
+		Org:             "myorg",
+		Space:           "myspace",
+		Region:          "us-south",
+		ResourceGroupID: "mygroup",
+		User:            "myuser",
+	}
+
+	for _, tc := range []struct {
+		description   string
+		context       ibmcloudv1.ResourceContext
+		contextErr    error
+		expectPatches []jsonpatch.JsonPatchOperation
+	}{
+		{
+			description: "empty context",
+			expectPatches: []jsonpatch.JsonPatchOperation{
+				{Operation: "add", Path: "/spec/context/org", Value: "myorg"},
+				{Operation: "add", Path: "/spec/context/space", Value: "myspace"},
+				{Operation: "add", Path: "/spec/context/region", Value: "us-south"},
+				{Operation: "add", Path: "/spec/context/resourcegroupid", Value: "mygroup"},
+				{Operation: "add", Path: "/spec/context/user", Value: "myuser"},
+			},
+		},
+		{
+			description: "context already set",
+			context:     defaultContext,
+		},
+		{
+			description: "defaults not found",
+			contextErr:  fmt.Errorf("failed"),
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			scheme := schemas(t)
+			decoder, err := admission.NewDecoder(scheme)
+			require.NoError(t, err)
+			d := &ServiceDefaulter{
+				Client: fake.NewFakeClientWithScheme(scheme),
+				Log:    testLogger(t),
+				GetDefaultContext: func(logt logr.Logger, r client.Client, instance *ibmcloudv1.Service, _ bool) (ibmcloudv1.ResourceContext, error) {
+					assert.Equal(t, "mynamespace", instance.Namespace)
+					if tc.contextErr != nil {
+						return ibmcloudv1.ResourceContext{}, tc.contextErr
+					}
+					return defaultContext, nil
+				},
+			}
+			require.NoError(t, d.InjectDecoder(decoder))
+
+			service := &ibmcloudv1.Service{
+				TypeMeta:   metav1.TypeMeta{Kind: "Service", APIVersion: "ibmcloud.ibm.com/v1"},
+				ObjectMeta: metav1.ObjectMeta{Name: "myservice"},
+				Spec: ibmcloudv1.ServiceSpec{
+					ServiceClass: "myclass",
+					Plan:         "Lite",
+					Context:      tc.context,
+				},
+			}
+			raw, err := json.Marshal(service)
+			require.NoError(t, err)
+
+			resp := d.Handle(context.Background(), admission.Request{
+				AdmissionRequest: admissionv1beta1.AdmissionRequest{
+					Namespace: "mynamespace",
+					Operation: admissionv1beta1.Create,
+					Object:    runtime.RawExtension{Raw: raw},
+				},
+			})
+			assert.True(t, resp.Allowed)
+			assert.ElementsMatch(t, tc.expectPatches, resp.Patches)
+		})
+	}
+}
@@ -57,6 +57,8 @@ const (
 	startupWait = 5 * time.Second
 )
 
+var testConditionTime = metav1.NewTime(time.Date(2021, time.January, 1, 0, 0, 0, 0, time.Local))
+
 func TestMain(m *testing.M) {
 	exitCode := run(m)
 	os.Exit(exitCode)
@@ -64,6 +66,7 @@ func TestMain(m *testing.M) {
 
 func run(m *testing.M) int {
 	flag.Parse() // required to check for '-short' flag setting
+	now = func() metav1.Time { return testConditionTime }
 	if testing.Short() {
 		return m.Run()
 	}
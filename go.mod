@@ -18,6 +18,7 @@ require (
 	github.com/stretchr/testify v1.6.1
 	go.uber.org/zap v1.10.0
 	golang.org/x/net v0.0.0-20210614182718-04defd469f4e // indirect
+	gomodules.xyz/jsonpatch/v2 v2.0.1
 	gopkg.in/yaml.v2 v2.2.8
 	k8s.io/api v0.17.17
 	k8s.io/apiextensions-apiserver v0.17.17
@@ -0,0 +1,47 @@
+// Package certificates decodes base64 encoded certificates embedded in a Binding's credentials
+package certificates
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// Parse parses the JSONPath of a certificate, such as {.connection.postgres.certificate.certificate_base64}.
+// The surrounding braces are optional.
+func Parse(key, path string) (*jsonpath.JSONPath, error) {
+	if !strings.HasPrefix(path, "{") {
+		path = "{" + path + "}"
+	}
+	parser := jsonpath.New(key)
+	if err := parser.Parse(path); err != nil {
+		return nil, err
+	}
+	return parser, nil
+}
+
+// Decode finds the base64 encoded certificate at path in credentials and returns it decoded
+func Decode(key, path string, credentials map[string]interface{}) ([]byte, error) {
+	parser, err := Parse(key, path)
+	if err != nil {
+		return nil, err
+	}
+	results, err := parser.FindResults(credentials)
+	if err != nil {
+		return nil, fmt.Errorf("certificate %s: %w", key, err)
+	}
+	if len(results) == 0 || len(results[0]) != 1 {
+		return nil, fmt.Errorf("certificate %s: %s must match exactly one value", key, path)
+	}
+	encoded, ok := results[0][0].Interface().(string)
+	if !ok {
+		return nil, fmt.Errorf("certificate %s: %s is not a string", key, path)
+	}
+	decoded, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("certificate %s: %s is not base64 encoded: %w", key, path, err)
+	}
+	return decoded, nil
+}
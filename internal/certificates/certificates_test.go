@@ -0,0 +1,72 @@
+package certificates
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecode(t *testing.T) {
+	t.Parallel()
+	const cert = "-----BEGIN CERTIFICATE-----\nMIIDDzCCAfegAwIBAgIJANEH58y2\n-----END CERTIFICATE-----\n"
+	credentials := map[string]interface{}{
+		"connection": map[string]interface{}{
+			"postgres": map[string]interface{}{
+				"certificate": map[string]interface{}{
+					"certificate_base64": base64.StdEncoding.EncodeToString([]byte(cert)),
+				},
+			},
+		},
+		"port":      float64(1234),
+		"malformed": "not base64!",
+	}
+
+	for _, tc := range []struct {
+		description string
+		path        string
+		expect      string
+		expectErr   string
+	}{
+		{
+			description: "with braces",
+			path:        "{.connection.postgres.certificate.certificate_base64}",
+			expect:      cert,
+		},
+		{
+			description: "without braces",
+			path:        ".connection.postgres.certificate.certificate_base64",
+			expect:      cert,
+		},
+		{
+			description: "missing",
+			path:        "{.connection.redis.certificate.certificate_base64}",
+			expectErr:   "certificate ca.crt: redis is not found",
+		},
+		{
+			description: "not a string",
+			path:        "{.port}",
+			expectErr:   "certificate ca.crt: {.port} is not a string",
+		},
+		{
+			description: "not base64",
+			path:        "{.malformed}",
+			expectErr:   "certificate ca.crt: {.malformed} is not base64 encoded: illegal base64 data at input byte 3",
+		},
+		{
+			description: "invalid path",
+			path:        "{.connection[}",
+			expectErr:   "unterminated array",
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			cert, err := Decode("ca.crt", tc.path, credentials)
+			if tc.expectErr != "" {
+				assert.EqualError(t, err, tc.expectErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expect, string(cert))
+		})
+	}
+}
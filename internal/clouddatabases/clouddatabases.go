@@ -0,0 +1,76 @@
+// Package clouddatabases flattens the nested connection details of IBM Cloud Databases credentials,
+// such as Databases for PostgreSQL, Redis and MongoDB, into the keys standard drivers expect
+package clouddatabases
+
+import (
+	"encoding/base64"
+	"fmt"
+	"sort"
+)
+
+// CACertKey is the key of the decoded CA certificate
+const CACertKey = "ca.crt"
+
+// ConnectionData returns the host, port, username, password, database, uri and ca.crt keys for IBM Cloud Databases credentials.
+// Returns nil if credentials do not have a database connection.
+func ConnectionData(credentials map[string]interface{}) map[string][]byte {
+	connection := findConnection(credentials)
+	if connection == nil {
+		return nil
+	}
+
+	data := make(map[string][]byte)
+	set := func(key string, value interface{}) {
+		if value != nil {
+			data[key] = []byte(fmt.Sprint(value))
+		}
+	}
+	if hosts, ok := connection["hosts"].([]interface{}); ok && len(hosts) > 0 {
+		if host, ok := hosts[0].(map[string]interface{}); ok {
+			set("host", host["hostname"])
+			set("port", host["port"])
+		}
+	}
+	if authentication, ok := connection["authentication"].(map[string]interface{}); ok {
+		set("username", authentication["username"])
+		set("password", authentication["password"])
+	}
+	set("database", connection["database"])
+	if composed, ok := connection["composed"].([]interface{}); ok && len(composed) > 0 {
+		set("uri", composed[0])
+	}
+	if certificate, ok := connection["certificate"].(map[string]interface{}); ok {
+		if encoded, ok := certificate["certificate_base64"].(string); ok {
+			if cert, err := base64.StdEncoding.DecodeString(encoded); err == nil {
+				data[CACertKey] = cert
+			}
+		}
+	}
+	return data
+}
+
+// findConnection returns the first database connection, by name, in credentials.
+// Database connections have hosts and a composed URI, unlike the "cli" connection.
+func findConnection(credentials map[string]interface{}) map[string]interface{} {
+	connections, ok := credentials["connection"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	names := make([]string, 0, len(connections))
+	for name := range connections {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		connection, ok := connections[name].(map[string]interface{})
+		if !ok || name == "cli" {
+			continue
+		}
+		_, hasHosts := connection["hosts"]
+		_, hasComposed := connection["composed"]
+		if hasHosts && hasComposed {
+			return connection
+		}
+	}
+	return nil
+}
@@ -7,8 +7,12 @@ import (
 	"github.com/ghodss/yaml"
 	"github.com/pkg/errors"
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 )
 
+// webhookCertVolume is the cert-manager volume added by config/default. OLM mounts its own webhook certificates instead.
+const webhookCertVolume = "cert"
+
 type Deployment struct {
 	Name string                `json:"name"`
 	Spec appsv1.DeploymentSpec `json:"spec"`
@@ -21,7 +25,31 @@ func getDeployments(output string) ([]Deployment, error) {
 		return nil, errors.Wrap(err, "Error reading generated deployment file. Did kustomize run yet?")
 	}
 	err = yaml.Unmarshal(deploymentBytes, &deployment)
+	if err != nil {
+		return nil, err
+	}
+	removeWebhookCertVolume(&deployment.Spec.Template.Spec)
 	return []Deployment{
 		{Name: deployment.Name, Spec: deployment.Spec},
-	}, err
+	}, nil
+}
+
+func removeWebhookCertVolume(pod *corev1.PodSpec) {
+	var volumes []corev1.Volume
+	for _, volume := range pod.Volumes {
+		if volume.Name != webhookCertVolume {
+			volumes = append(volumes, volume)
+		}
+	}
+	pod.Volumes = volumes
+
+	for i := range pod.Containers {
+		var mounts []corev1.VolumeMount
+		for _, mount := range pod.Containers[i].VolumeMounts {
+			if mount.Name != webhookCertVolume {
+				mounts = append(mounts, mount)
+			}
+		}
+		pod.Containers[i].VolumeMounts = mounts
+	}
 }
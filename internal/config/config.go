@@ -16,17 +16,21 @@ type Config struct {
 	APIKey                  string        `envconfig:"bluemix_api_key"`
 	AccountID               string        `envconfig:"bluemix_account_id"`
 	ControllerNamespace     string        `envconfig:"controller_namespace"`
+	EnableWebhooks          bool          `envconfig:"enable_webhooks"`
+	InstanceCRNNamespaces   []string      `envconfig:"instance_crn_namespaces"`
 	MaxConcurrentReconciles int           `envconfig:"max_concurrent_reconciles"`
 	Org                     string        `envconfig:"bluemix_org"`
 	Region                  string        `envconfig:"bluemix_region"`
 	ResourceGroupName       string        `envconfig:"bluemix_resource_group"`
 	Space                   string        `envconfig:"bluemix_space"`
+	StrictNamespaces        bool          `envconfig:"strict_namespaces"`
 	SyncPeriod              time.Duration `envconfig:"sync_period"`
 }
 
 func Get() Config {
 	loadOnce.Do(func() {
 		config = Config{ // default values
+			EnableWebhooks:          true,
 			MaxConcurrentReconciles: 1,
 			SyncPeriod:              150 * time.Second,
 		}
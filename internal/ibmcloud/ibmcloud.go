@@ -59,22 +59,23 @@ type Info struct {
 	Context          ibmcloudv1.ResourceContext
 }
 
-// GetInfo initializes sessions and sets up a struct to faciliate making calls to bx
-func GetInfo(logt logr.Logger, r client.Client, instance *ibmcloudv1.Service) (*Info, error) {
-	bxConfig, err := getBxConfig(logt, r, instance)
+// GetInfo initializes sessions and sets up a struct to faciliate making calls to bx.
+// If strictNamespaces is set, the operator's secrets and configmaps are not looked up in the default namespace.
+func GetInfo(logt logr.Logger, r client.Client, instance *ibmcloudv1.Service, strictNamespaces bool) (*Info, error) {
+	bxConfig, err := getBxConfig(logt, r, instance, strictNamespaces)
 	if err != nil {
 		return nil, err
 	}
 
-	ibmCloudContext, err := getIBMCloudDefaultContext(logt, r, instance)
+	ibmCloudContext, err := getIBMCloudDefaultContext(logt, r, instance, strictNamespaces)
 	if err != nil {
 		return nil, err
 	}
 
-	return getInfoHelper(logt, r, &bxConfig, ibmCloudContext, instance)
+	return getInfoHelper(logt, r, &bxConfig, ibmCloudContext, instance, strictNamespaces)
 }
 
-func getInfoHelper(logt logr.Logger, r client.Client, config *bluemix.Config, nctx ibmcloudv1.ResourceContext, instance *ibmcloudv1.Service) (*Info, error) {
+func getInfoHelper(logt logr.Logger, r client.Client, config *bluemix.Config, nctx ibmcloudv1.ResourceContext, instance *ibmcloudv1.Service, strictNamespaces bool) (*Info, error) {
 	servicename := instance.Spec.ServiceClass
 	servicetype := instance.Spec.ServiceClassType
 	serviceplan := instance.Spec.Plan
@@ -143,7 +144,7 @@ func getInfoHelper(logt logr.Logger, r client.Client, config *bluemix.Config, nc
 		}, nil
 	}
 
-	IAMAccessToken, IAMRefreshToken, UAAAccessToken, UAARefreshToken, err := getIamToken(logt, r, instance)
+	IAMAccessToken, IAMRefreshToken, UAAAccessToken, UAARefreshToken, err := getIamToken(logt, r, instance, strictNamespaces)
 	if err == nil {
 		config.IAMAccessToken = IAMAccessToken
 		config.IAMRefreshToken = IAMRefreshToken
@@ -230,13 +231,13 @@ func getInfoHelper(logt logr.Logger, r client.Client, config *bluemix.Config, nc
 	}, nil
 }
 
-func getBxConfig(logt logr.Logger, r client.Client, instance *ibmcloudv1.Service) (bluemix.Config, error) {
+func getBxConfig(logt logr.Logger, r client.Client, instance *ibmcloudv1.Service, strictNamespaces bool) (bluemix.Config, error) {
 	secretName := seedSecret
 	secretNameSpace := instance.ObjectMeta.Namespace
 
 	secret := &v1.Secret{}
 
-	err := getConfigOrSecret(logt, r, secretNameSpace, secretName, secret)
+	err := getConfigOrSecret(logt, r, strictNamespaces, secretNameSpace, secretName, secret)
 	if err != nil {
 		logt.Info("Unable to get IBM Cloud Operator secret in namespace", secretNameSpace, err)
 		return bluemix.Config{}, err
@@ -259,7 +260,17 @@ func getBxConfig(logt logr.Logger, r client.Client, instance *ibmcloudv1.Service
 	return c, nil
 }
 
-func getIBMCloudDefaultContext(logt logr.Logger, r client.Client, instance *ibmcloudv1.Service) (ibmcloudv1.ResourceContext, error) {
+// DefaultContextGetter resolves the IBM Cloud context for a Service, filling in unset fields from the ibmcloud-operator-defaults ConfigMap
+type DefaultContextGetter func(logt logr.Logger, r client.Client, instance *ibmcloudv1.Service, strictNamespaces bool) (ibmcloudv1.ResourceContext, error)
+
+var _ DefaultContextGetter = GetDefaultContext
+
+// GetDefaultContext resolves the IBM Cloud context for a Service, filling in unset fields from the ibmcloud-operator-defaults ConfigMap
+func GetDefaultContext(logt logr.Logger, r client.Client, instance *ibmcloudv1.Service, strictNamespaces bool) (ibmcloudv1.ResourceContext, error) {
+	return getIBMCloudDefaultContext(logt, r, instance, strictNamespaces)
+}
+
+func getIBMCloudDefaultContext(logt logr.Logger, r client.Client, instance *ibmcloudv1.Service, strictNamespaces bool) (ibmcloudv1.ResourceContext, error) {
 	// If the object already has the context set in its Status, then we don't read from the configmap
 	if !reflect.DeepEqual(instance.Status.Context, ibmcloudv1.ResourceContext{}) {
 		return instance.Status.Context, nil
@@ -269,7 +280,7 @@ func getIBMCloudDefaultContext(logt logr.Logger, r client.Client, instance *ibmc
 	cmName := seedDefaults
 	cmNameSpace := instance.ObjectMeta.Namespace
 
-	err := getConfigOrSecret(logt, r, cmNameSpace, cmName, cm)
+	err := getConfigOrSecret(logt, r, strictNamespaces, cmNameSpace, cmName, cm)
 	if err != nil {
 		logt.Info("Unable to get IBM Cloud Operator configmap in namespace", cmNameSpace, err)
 		return ibmcloudv1.ResourceContext{}, err
@@ -279,12 +290,12 @@ func getIBMCloudDefaultContext(logt logr.Logger, r client.Client, instance *ibmc
 	return ibmCloudContext, nil
 }
 
-func getIamToken(logt logr.Logger, r client.Client, instance *ibmcloudv1.Service) (string, string, string, string, error) {
+func getIamToken(logt logr.Logger, r client.Client, instance *ibmcloudv1.Service, strictNamespaces bool) (string, string, string, string, error) {
 	secretName := seedTokens
 	secretNameSpace := instance.ObjectMeta.Namespace
 
 	secret := &v1.Secret{}
-	err := getConfigOrSecret(logt, r, secretNameSpace, secretName, secret)
+	err := getConfigOrSecret(logt, r, strictNamespaces, secretNameSpace, secretName, secret)
 	if err != nil {
 		logt.Info("Unable to get IBM Cloud Operator IAM token in namespace", secretNameSpace, err)
 		return "", "", "", "", err
@@ -293,7 +304,22 @@ func getIamToken(logt logr.Logger, r client.Client, instance *ibmcloudv1.Service
 	return string(secret.Data["access_token"]), string(secret.Data["refresh_token"]), string(secret.Data["uaa_refresh_token"]), string(secret.Data["uaa_token"]), nil
 }
 
-func getConfigOrSecret(logt logr.Logger, r client.Client, instanceNamespace string, objName string, obj runtime.Object) error {
+// strictNamespacesError is a not found error for a secret or configmap which was not looked up in the default namespace.
+// It keeps the API status of the wrapped error, so errors.IsNotFound still detects it.
+type strictNamespacesError struct {
+	*errors.StatusError
+	message string
+}
+
+func (e *strictNamespacesError) Error() string {
+	return e.message
+}
+
+func (e *strictNamespacesError) Unwrap() error {
+	return e.StatusError
+}
+
+func getConfigOrSecret(logt logr.Logger, r client.Client, strictNamespaces bool, instanceNamespace string, objName string, obj runtime.Object) error {
 	defaultNamespace, isManagement := getDefaultNamespace(logt, r)
 	if isManagement {
 		objName = instanceNamespace + "-" + objName
@@ -307,6 +333,16 @@ func getConfigOrSecret(logt logr.Logger, r client.Client, instanceNamespace stri
 	err := r.Get(context.TODO(), types.NamespacedName{Name: objName, Namespace: instanceNamespace}, obj)
 	if err != nil {
 		if errors.IsNotFound(err) {
+			if strictNamespaces && instanceNamespace != defaultNamespace {
+				logt.Info("Unable to find secret or config in same namespace, strict namespaces are enabled so not checking default namespace", "secret or config", objName, "namespace", instanceNamespace, "default namespace", defaultNamespace)
+				if statusErr, ok := err.(*errors.StatusError); ok {
+					return &strictNamespacesError{
+						StatusError: statusErr,
+						message:     fmt.Sprintf("%s in namespace %s: strict namespaces are enabled, so namespace %s was not checked", statusErr.Error(), instanceNamespace, defaultNamespace),
+					}
+				}
+				return err
+			}
 			err = r.Get(context.TODO(), types.NamespacedName{Name: objName, Namespace: defaultNamespace}, obj)
 			if err != nil {
 				logt.Info("Unable to find secret or config in same namespace or default namespace", "secret or config", objName, "namespace", instanceNamespace, "default namespace", defaultNamespace)
@@ -0,0 +1,40 @@
+package ibmcloud
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake" // nolint: staticcheck
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func TestGetConfigOrSecret(t *testing.T) {
+	t.Parallel()
+	var logt logr.Logger = log.NullLogger{}
+	client := fake.NewFakeClientWithScheme(scheme.Scheme, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "mysecret", Namespace: "default"},
+	})
+
+	t.Run("falls back to the default namespace", func(t *testing.T) {
+		err := getConfigOrSecret(logt, client, false, "mynamespace", "mysecret", &corev1.Secret{})
+		assert.NoError(t, err)
+	})
+
+	t.Run("strict namespaces", func(t *testing.T) {
+		err := getConfigOrSecret(logt, client, true, "mynamespace", "mysecret", &corev1.Secret{})
+		require.Error(t, err)
+		assert.EqualError(t, err, `secrets "mysecret" not found in namespace mynamespace: strict namespaces are enabled, so namespace default was not checked`)
+		assert.True(t, k8sErrors.IsNotFound(err))
+
+		var statusErr *k8sErrors.StatusError
+		require.True(t, errors.As(err, &statusErr))
+		assert.Equal(t, `secrets "mysecret" not found`, statusErr.ErrStatus.Message, "The wrapped error should not be changed")
+	})
+}
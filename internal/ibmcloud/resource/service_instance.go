@@ -58,6 +58,24 @@ func CreateServiceInstance(session *session.Session, externalName, servicePlanID
 	return serviceInstance.ID, serviceInstance.LastOperation.State, nil
 }
 
+type ServiceInstanceGetter func(session *session.Session, instanceID string) (serviceClass, servicePlanID, state string, err error)
+
+var _ ServiceInstanceGetter = GetServiceInstance
+
+// GetServiceInstance retrieves the service class name, plan ID and state of the service instance with the given ID
+func GetServiceInstance(session *session.Session, instanceID string) (serviceClass, servicePlanID, state string, err error) {
+	controllerClient, err := controller.New(session)
+	if err != nil {
+		return "", "", "", err
+	}
+	resServiceInstanceAPI := controllerClient.ResourceServiceInstance()
+	model, err := resServiceInstanceAPI.GetInstance(instanceID)
+	if err != nil {
+		return "", "", "", err
+	}
+	return model.Crn.ServiceName, model.ServicePlanID, model.State, nil
+}
+
 type ServiceInstanceStatusGetter func(session *session.Session, resourceGroupID, servicePlanID, externalName, instanceID string) (state string, err error)
 
 var _ ServiceInstanceStatusGetter = GetServiceInstanceState
@@ -0,0 +1,40 @@
+// Package secrettemplate renders a Binding's secretTemplate into secret data
+package secrettemplate
+
+import (
+	"bytes"
+	"encoding/json"
+	"text/template"
+)
+
+var funcs = template.FuncMap{
+	"json": toJSON,
+}
+
+// Parse parses the template for a single secret key
+func Parse(key, text string) (*template.Template, error) {
+	return template.New(key).Funcs(funcs).Option("missingkey=error").Parse(text)
+}
+
+// Render executes each template against the credentials, returning the secret data keyed by template name
+func Render(templates map[string]string, credentials map[string]interface{}) (map[string][]byte, error) {
+	data := make(map[string][]byte, len(templates))
+	for key, text := range templates {
+		tmpl, err := Parse(key, text)
+		if err != nil {
+			return nil, err
+		}
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, credentials); err != nil {
+			return nil, err
+		}
+		data[key] = buf.Bytes()
+	}
+	return data, nil
+}
+
+// toJSON renders nested credential values, such as objects and lists, as JSON
+func toJSON(v interface{}) (string, error) {
+	b, err := json.Marshal(v)
+	return string(b), err
+}
@@ -0,0 +1,85 @@
+// Package servicebinding adds the entries of the Service Binding Specification for Kubernetes to a Binding's credentials.
+// See https://servicebinding.io/spec/core/1.0.0/#well-known-secret-entries
+package servicebinding
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Provider is the provider entry of every generated secret
+const Provider = "ibmcloud"
+
+const (
+	typeKey     = "type"
+	providerKey = "provider"
+)
+
+// types maps IBM Cloud service classes to the conventional binding type of their service
+var types = map[string]string{
+	"cloud-object-storage":        "s3",
+	"cloudantnosqldb":             "couchdb",
+	"databases-for-elasticsearch": "elasticsearch",
+	"databases-for-etcd":          "etcd",
+	"databases-for-mongodb":       "mongodb",
+	"databases-for-mysql":         "mysql",
+	"databases-for-postgresql":    "postgresql",
+	"databases-for-redis":         "redis",
+	"messagehub":                  "kafka",
+	"messages-for-rabbitmq":       "rabbitmq",
+}
+
+// wellKnownAliases maps each well-known entry to credential fields holding the same value, in order of preference
+var wellKnownAliases = map[string][]string{
+	"host":     {"host", "hostname"},
+	"port":     {"port"},
+	"username": {"username", "user"},
+	"password": {"password"},
+	"uri":      {"uri", "url"},
+}
+
+// Type returns the binding type for a service class, falling back to the service class itself
+func Type(serviceClass string) string {
+	if bindingType, ok := types[serviceClass]; ok {
+		return bindingType
+	}
+	return serviceClass
+}
+
+// SecretType returns the secret type for a binding type, as recommended by the specification
+func SecretType(bindingType string) corev1.SecretType {
+	return corev1.SecretType("servicebinding.io/" + bindingType)
+}
+
+// Credentials returns a copy of credentials with the type and provider entries, and any well-known entries found under a different name
+func Credentials(serviceClass string, credentials map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(credentials)+2)
+	for key, value := range credentials {
+		result[key] = value
+	}
+	result[typeKey] = Type(serviceClass)
+	result[providerKey] = Provider
+	for key, aliases := range wellKnownAliases {
+		if _, exists := result[key]; exists {
+			continue
+		}
+		for _, alias := range aliases {
+			if value, ok := credentials[alias]; ok {
+				result[key] = value
+				break
+			}
+		}
+	}
+	return result
+}
+
+// AddEntries sets the type and provider entries of rendered secret data from credentials, if they are missing
+func AddEntries(data map[string][]byte, credentials map[string]interface{}) {
+	for _, key := range []string{typeKey, providerKey} {
+		if _, exists := data[key]; exists {
+			continue
+		}
+		if value, ok := credentials[key].(string); ok {
+			data[key] = []byte(value)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package servicebinding
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestType(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "postgresql", Type("databases-for-postgresql"))
+	assert.Equal(t, "language-translator", Type("language-translator"))
+	assert.Equal(t, corev1.SecretType("servicebinding.io/postgresql"), SecretType("postgresql"))
+}
+
+func TestCredentials(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		description  string
+		serviceClass string
+		credentials  map[string]interface{}
+		expect       map[string]interface{}
+	}{
+		{
+			description:  "type and provider",
+			serviceClass: "databases-for-redis",
+			credentials:  map[string]interface{}{"connection": map[string]interface{}{}},
+			expect: map[string]interface{}{
+				"connection": map[string]interface{}{},
+				"type":       "redis",
+				"provider":   "ibmcloud",
+			},
+		},
+		{
+			description:  "well-known aliases",
+			serviceClass: "language-translator",
+			credentials: map[string]interface{}{
+				"apikey":   "myapikey",
+				"hostname": "example.com",
+				"url":      "https://example.com",
+			},
+			expect: map[string]interface{}{
+				"apikey":   "myapikey",
+				"hostname": "example.com",
+				"url":      "https://example.com",
+				"host":     "example.com",
+				"uri":      "https://example.com",
+				"type":     "language-translator",
+				"provider": "ibmcloud",
+			},
+		},
+		{
+			description:  "well-known entries are not replaced",
+			serviceClass: "myservice",
+			credentials: map[string]interface{}{
+				"uri": "https://example.com/uri",
+				"url": "https://example.com/url",
+			},
+			expect: map[string]interface{}{
+				"uri":      "https://example.com/uri",
+				"url":      "https://example.com/url",
+				"type":     "myservice",
+				"provider": "ibmcloud",
+			},
+		},
+	} {
+		t.Run(tc.description, func(t *testing.T) {
+			assert.Equal(t, tc.expect, Credentials(tc.serviceClass, tc.credentials))
+		})
+	}
+}
+
+func TestAddEntries(t *testing.T) {
+	t.Parallel()
+	data := map[string][]byte{"type": []byte("custom")}
+	AddEntries(data, map[string]interface{}{"type": "postgresql", "provider": "ibmcloud"})
+	assert.Equal(t, map[string][]byte{
+		"type":     []byte("custom"),
+		"provider": []byte("ibmcloud"),
+	}, data)
+}
@@ -21,6 +21,7 @@ import (
 	"os"
 
 	"github.com/ibm/cloud-operators/controllers"
+	"github.com/ibm/cloud-operators/internal/config"
 	"go.uber.org/zap"
 	"k8s.io/apimachinery/pkg/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
@@ -76,6 +77,14 @@ func main() {
 		os.Exit(1)
 	}
 
+	if config.Get().EnableWebhooks {
+		if err := controllers.SetUpWebhooks(mgr); err != nil {
+			setupLog.Error(err, "Unable to set up webhooks")
+			os.Exit(1)
+		}
+	}
+	// +kubebuilder:scaffold:builder
+
 	setupLog.Info("starting manager")
 	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
 		setupLog.Error(err, "problem running manager")